@@ -0,0 +1,40 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Account describes the account that owns the API key, including its usage limits
+type Account struct {
+	Name                   string           `json:"name" yaml:"name"`
+	BillingURL             string           `json:"billingUrl,omitempty" yaml:"billingUrl,omitempty"`
+	FreeTimeUsed           UltravoxDuration `json:"freeTimeUsed,omitempty" yaml:"freeTimeUsed,omitempty"`
+	FreeTimeRemaining      UltravoxDuration `json:"freeTimeRemaining,omitempty" yaml:"freeTimeRemaining,omitempty"`
+	HasActiveSubscription  bool             `json:"hasActiveSubscription,omitempty" yaml:"hasActiveSubscription,omitempty"`
+	ActiveCalls            int              `json:"activeCalls" yaml:"activeCalls"`
+	AllowedConcurrentCalls int              `json:"allowedConcurrentCalls,omitempty" yaml:"allowedConcurrentCalls,omitempty"`
+}
+
+// GetAccount retrieves the account details and call quota for the configured API key
+func (c *Client) GetAccount(ctx context.Context) (*Account, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.get_account")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/accounts/me", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+	if err := c.do(req, &account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
@@ -0,0 +1,69 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetAccount(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/accounts/me", req.URL.Path)
+			assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"name": "Acme Telephony",
+					"billingUrl": "https://billing.example.com/acme",
+					"freeTimeUsed": "1800s",
+					"freeTimeRemaining": "1800.500s",
+					"hasActiveSubscription": true,
+					"activeCalls": 3,
+					"allowedConcurrentCalls": 10
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	account, err := client.GetAccount(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme Telephony", account.Name)
+	assert.Equal(t, "https://billing.example.com/acme", account.BillingURL)
+	assert.Equal(t, ultravox.UltravoxDuration(30*time.Minute), account.FreeTimeUsed)
+	assert.Equal(t, ultravox.UltravoxDuration(30*time.Minute+500*time.Millisecond), account.FreeTimeRemaining)
+	assert.True(t, account.HasActiveSubscription)
+	assert.Equal(t, 3, account.ActiveCalls)
+	assert.Equal(t, 10, account.AllowedConcurrentCalls)
+}
+
+func TestClient_GetAccount_Unauthorized(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Invalid API key."}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("bad-key"))
+	client.WithHTTPClient(mockClient)
+
+	account, err := client.GetAccount(context.Background())
+	assert.Nil(t, account)
+	assert.ErrorIs(t, err, ultravox.ErrUnauthorized)
+}
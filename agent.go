@@ -0,0 +1,184 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Agent is a persistent agent whose call template provides the defaults for calls made to it
+type Agent struct {
+	AgentID     string `json:"agentId" yaml:"agentId"`
+	Name        string `json:"name" yaml:"name"`
+	Created     string `json:"created" yaml:"created"`
+	CallRequest `json:"callTemplate" yaml:"callTemplate"`
+}
+
+// AgentDefinition describes an agent to create
+type AgentDefinition struct {
+	Name         string       `json:"name,omitempty" yaml:"name,omitempty"`
+	CallTemplate *CallRequest `json:"callTemplate,omitempty" yaml:"callTemplate,omitempty"`
+}
+
+// AgentPatch describes changes to an existing agent; unset fields are left unchanged
+type AgentPatch = AgentDefinition
+
+// AgentRequest is the request body for creating or updating an agent. It is
+// the same type as AgentDefinition and AgentPatch, so one value can be passed
+// to both CreateAgent and UpdateAgent.
+type AgentRequest = AgentDefinition
+
+// NewAgentDefinition builds an agent definition whose call template is configured
+// with the same options accepted by Client.Call. Errors raised by the options
+// are returned by CreateAgent and UpdateAgent, which also resolve a
+// WithCallDynamicSystemPrompt with their context.
+func NewAgentDefinition(name string, opts ...CallOption) *AgentDefinition {
+	template := &CallRequest{}
+	for _, opt := range opts {
+		opt(template)
+	}
+	return &AgentDefinition{Name: name, CallTemplate: template}
+}
+
+// prepare returns the definition to send: option errors are reported and a
+// dynamic system prompt is resolved with ctx into a copy of the call template
+func (d *AgentDefinition) prepare(ctx context.Context) (*AgentDefinition, error) {
+	if d.CallTemplate == nil {
+		return d, nil
+	}
+	if err := d.CallTemplate.optionErr; err != nil {
+		return nil, fmt.Errorf("invalid call template: %w", err)
+	}
+	if d.CallTemplate.dynamicSystemPrompt == nil {
+		return d, nil
+	}
+
+	template := d.CallTemplate.clone()
+	if err := template.resolveDynamicSystemPrompt(ctx); err != nil {
+		return nil, fmt.Errorf("invalid call template: %w", err)
+	}
+	resolved := *d
+	resolved.CallTemplate = &template
+	return &resolved, nil
+}
+
+// AgentPage is a single page of agents returned by ListAgents
+type AgentPage struct {
+	Results  []Agent `json:"results"`
+	Next     string  `json:"next,omitempty"`
+	Previous string  `json:"previous,omitempty"`
+	Total    int     `json:"total,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *AgentPage) NextCursor() string {
+	return cursorFromURL(p.Next)
+}
+
+// ListAgents returns a page of the agents in the account
+func (c *Client) ListAgents(ctx context.Context, opts ...ListOption) (*AgentPage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_agents")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, buildListURL("/agents", opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page AgentPage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetAgent retrieves an agent by its ID
+func (c *Client) GetAgent(ctx context.Context, agentID string) (*Agent, error) {
+	if agentID == "" {
+		return nil, fmt.Errorf("agent ID is required")
+	}
+	return c.sendAgent(ctx, "ultravox.get_agent", http.MethodGet, agentID, nil)
+}
+
+// CreateAgent creates a new agent from def
+func (c *Client) CreateAgent(ctx context.Context, def *AgentDefinition) (*Agent, error) {
+	if def == nil || def.Name == "" {
+		return nil, fmt.Errorf("agent name is required")
+	}
+	def, err := def.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendAgent(ctx, "ultravox.create_agent", http.MethodPost, "", def)
+}
+
+// UpdateAgent applies patch to an existing agent and returns the updated agent
+func (c *Client) UpdateAgent(ctx context.Context, agentID string, patch *AgentPatch) (*Agent, error) {
+	if agentID == "" {
+		return nil, fmt.Errorf("agent ID is required")
+	}
+	if patch == nil {
+		return nil, fmt.Errorf("agent patch is required")
+	}
+	patch, err := patch.prepare(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.sendAgent(ctx, "ultravox.update_agent", http.MethodPatch, agentID, patch)
+}
+
+// DeleteAgent deletes an agent by its ID
+func (c *Client) DeleteAgent(ctx context.Context, agentID string) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+	if agentID == "" {
+		return fmt.Errorf("agent ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.delete_agent", attribute.String("agent.id", agentID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodDelete, "/agents/"+url.PathEscape(agentID), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// sendAgent performs a request against the agents collection, or a single agent
+// when agentID is set, and decodes the agent returned by the API
+func (c *Client) sendAgent(ctx context.Context, spanName, method, agentID string, body interface{}) (*Agent, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, spanName, attribute.String("agent.id", agentID))
+	defer span.End()
+
+	path := "/agents"
+	if agentID != "" {
+		path += "/" + url.PathEscape(agentID)
+	}
+
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var agent Agent
+	if err := c.do(req, &agent); err != nil {
+		return nil, err
+	}
+
+	return &agent, nil
+}
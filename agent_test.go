@@ -0,0 +1,344 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const agentResponse = `{
+	"agentId": "agent-123",
+	"name": "support",
+	"created": "2024-01-02T03:04:05Z",
+	"callTemplate": {
+		"systemPrompt": "You are a support agent.",
+		"voice": "Mark",
+		"maxDuration": "600s"
+	}
+}`
+
+func TestClient_ListAgents(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/agents", req.URL.Path)
+			assert.Equal(t, "abc", req.URL.Query().Get("cursor"))
+			assert.Equal(t, "10", req.URL.Query().Get("pageSize"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"results": [` + agentResponse + `],
+					"next": "https://api.ultravox.ai/api/agents?cursor=def",
+					"total": 11
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	page, err := client.ListAgents(context.Background(), ultravox.WithCursor("abc"), ultravox.WithPageSize(10))
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "agent-123", page.Results[0].AgentID)
+	assert.Equal(t, "You are a support agent.", page.Results[0].SystemPrompt)
+	assert.Equal(t, 11, page.Total)
+	assert.Equal(t, "def", page.NextCursor())
+}
+
+func TestClient_GetAgent(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        bool
+		wantNotFound   bool
+	}{
+		{
+			name:           "Successful retrieval",
+			mockResponse:   agentResponse,
+			mockStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Agent not found",
+			mockResponse:   `{"detail": "Not found."}`,
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        true,
+			wantNotFound:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "https://api.ultravox.ai/api/agents/agent-123", req.URL.String())
+					assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.mockResponse)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			agent, err := client.GetAgent(context.Background(), "agent-123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantNotFound, errors.Is(err, ultravox.ErrNotFound))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "agent-123", agent.AgentID)
+			assert.Equal(t, "support", agent.Name)
+			assert.Equal(t, "2024-01-02T03:04:05Z", agent.Created)
+			assert.Equal(t, "Mark", agent.Voice)
+		})
+	}
+}
+
+func TestClient_CreateAgent(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/agents", req.URL.String())
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "support", body["name"])
+
+			template := body["callTemplate"].(map[string]interface{})
+			assert.Equal(t, "You are a support agent.", template["systemPrompt"])
+			assert.Equal(t, "Mark", template["voice"])
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(agentResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	def := ultravox.NewAgentDefinition("support",
+		ultravox.WithCallSystemPrompt("You are a support agent."),
+		ultravox.WithCallVoice("Mark"),
+	)
+
+	agent, err := client.CreateAgent(context.Background(), def)
+	require.NoError(t, err)
+	assert.Equal(t, "agent-123", agent.AgentID)
+
+	_, err = client.CreateAgent(context.Background(), &ultravox.AgentDefinition{})
+	assert.Error(t, err)
+}
+
+func TestClient_AgentOptionErrors(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request should be sent for an invalid call template")
+			return nil, nil
+		},
+	})
+
+	tests := []struct {
+		name    string
+		opt     ultravox.CallOption
+		wantErr string
+	}{
+		{
+			name:    "Bad template",
+			opt:     ultravox.WithCallSystemPromptTemplate("Hello {{.Missing", nil),
+			wantErr: "invalid call template",
+		},
+		{
+			name:    "Missing file",
+			opt:     ultravox.WithCallSystemPromptFromFile("testdata/does-not-exist.txt", nil),
+			wantErr: "invalid call template",
+		},
+		{
+			name:    "Tool not selected",
+			opt:     ultravox.WithCallToolAuthToken("lookup", "k", "secret"),
+			wantErr: `tool "lookup" is not selected`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := ultravox.NewAgentDefinition("support", tt.opt)
+
+			_, err := client.CreateAgent(context.Background(), def)
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			_, err = client.UpdateAgent(context.Background(), "agent-123", def)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestClient_AgentDynamicSystemPrompt(t *testing.T) {
+	var prompts []interface{}
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			prompts = append(prompts, body["callTemplate"].(map[string]interface{})["systemPrompt"])
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(agentResponse)),
+			}, nil
+		},
+	})
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "Acme")
+	def := ultravox.NewAgentDefinition("support", ultravox.WithCallDynamicSystemPrompt(func(ctx context.Context) (string, error) {
+		return fmt.Sprintf("You support %s customers.", ctx.Value(ctxKey{})), nil
+	}))
+
+	_, err := client.CreateAgent(ctx, def)
+	require.NoError(t, err)
+	_, err = client.UpdateAgent(ctx, "agent-123", def)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"You support Acme customers.", "You support Acme customers."}, prompts)
+	assert.Empty(t, def.CallTemplate.SystemPrompt, "the caller's definition is not modified")
+
+	t.Run("Error", func(t *testing.T) {
+		def := ultravox.NewAgentDefinition("support", ultravox.WithCallDynamicSystemPrompt(func(context.Context) (string, error) {
+			return "", errors.New("prompt service unavailable")
+		}))
+		_, err := client.CreateAgent(context.Background(), def)
+		assert.ErrorContains(t, err, "prompt service unavailable")
+	})
+}
+
+func TestClient_UpdateAgent(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPatch, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/agents/agent-123", req.URL.String())
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.NotContains(t, body, "name")
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(agentResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	patch := &ultravox.AgentPatch{
+		CallTemplate: &ultravox.CallRequest{Voice: "Mark"},
+	}
+
+	agent, err := client.UpdateAgent(context.Background(), "agent-123", patch)
+	require.NoError(t, err)
+	assert.Equal(t, "Mark", agent.Voice)
+}
+
+func TestClient_DeleteAgent(t *testing.T) {
+	for _, status := range []int{http.StatusNoContent, http.StatusNotFound} {
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodDelete, req.Method)
+				assert.Equal(t, "https://api.ultravox.ai/api/agents/agent-123", req.URL.String())
+
+				return &http.Response{
+					StatusCode: status,
+					Body:       io.NopCloser(bytes.NewBufferString("")),
+				}, nil
+			},
+		}
+
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(mockClient)
+
+		err := client.DeleteAgent(context.Background(), "agent-123")
+		if status == http.StatusNotFound {
+			assert.ErrorIs(t, err, ultravox.ErrNotFound)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestClient_AgentRequest(t *testing.T) {
+	var methods []string
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			methods = append(methods, req.Method)
+
+			var body struct {
+				Name         string `json:"name"`
+				CallTemplate struct {
+					SystemPrompt         string                         `json:"systemPrompt"`
+					Model                string                         `json:"model"`
+					Voice                string                         `json:"voice"`
+					FirstSpeakerSettings *ultravox.FirstSpeakerSettings `json:"firstSpeakerSettings"`
+					SelectedTools        []ultravox.SelectedTool        `json:"selectedTools"`
+				} `json:"callTemplate"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "support", body.Name)
+			assert.Equal(t, "You are a support agent.", body.CallTemplate.SystemPrompt)
+			assert.Equal(t, "fixie-ai/ultravox", body.CallTemplate.Model)
+			assert.Equal(t, "Mark", body.CallTemplate.Voice)
+			assert.NotNil(t, body.CallTemplate.FirstSpeakerSettings)
+			require.Len(t, body.CallTemplate.SelectedTools, 1)
+			assert.Equal(t, "hangUp", body.CallTemplate.SelectedTools[0].ToolName)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(agentResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	req := &ultravox.AgentRequest{
+		Name: "support",
+		CallTemplate: &ultravox.CallRequest{
+			SystemPrompt:         "You are a support agent.",
+			Model:                "fixie-ai/ultravox",
+			Voice:                "Mark",
+			FirstSpeakerSettings: ultravox.AgentFirstSpeaker(false, "Hello", "", 0),
+			SelectedTools:        []ultravox.SelectedTool{{ToolName: "hangUp"}},
+		},
+	}
+
+	_, err := client.CreateAgent(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = client.UpdateAgent(context.Background(), "agent-123", req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{http.MethodPost, http.MethodPatch}, methods)
+}
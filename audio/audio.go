@@ -0,0 +1,40 @@
+// Package audio provides the sample conversions needed to bridge telephony and
+// WebRTC audio to the 16-bit PCM used by Ultravox.
+package audio
+
+import "encoding/binary"
+
+// PCM16ToBytes serialises 16-bit samples using the given byte order.
+// Ultravox WebSocket audio is little-endian.
+func PCM16ToBytes(pcm []int16, order binary.ByteOrder) []byte {
+	dst := make([]byte, len(pcm)*2)
+	PCM16ToBytesInto(dst, pcm, order)
+	return dst
+}
+
+// PCM16ToBytesInto serialises pcm into dst, which must hold at least 2*len(pcm)
+// bytes, and returns the number of bytes written
+func PCM16ToBytesInto(dst []byte, pcm []int16, order binary.ByteOrder) int {
+	for i, s := range pcm {
+		order.PutUint16(dst[i*2:], uint16(s))
+	}
+	return len(pcm) * 2
+}
+
+// BytesToPCM16 parses 16-bit samples using the given byte order.
+// A trailing odd byte is ignored.
+func BytesToPCM16(b []byte, order binary.ByteOrder) []int16 {
+	dst := make([]int16, len(b)/2)
+	BytesToPCM16Into(dst, b, order)
+	return dst
+}
+
+// BytesToPCM16Into parses b into dst, which must hold at least len(b)/2
+// samples, and returns the number of samples written
+func BytesToPCM16Into(dst []int16, b []byte, order binary.ByteOrder) int {
+	n := len(b) / 2
+	for i := 0; i < n; i++ {
+		dst[i] = int16(order.Uint16(b[i*2:]))
+	}
+	return n
+}
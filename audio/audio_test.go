@@ -0,0 +1,39 @@
+package audio_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/paulgrammer/ultravox/audio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPCM16Bytes(t *testing.T) {
+	pcm := []int16{1, -2, 0x1234}
+
+	t.Run("Little endian", func(t *testing.T) {
+		b := audio.PCM16ToBytes(pcm, binary.LittleEndian)
+		assert.Equal(t, []byte{0x01, 0x00, 0xFE, 0xFF, 0x34, 0x12}, b)
+		assert.Equal(t, pcm, audio.BytesToPCM16(b, binary.LittleEndian))
+	})
+
+	t.Run("Big endian", func(t *testing.T) {
+		b := audio.PCM16ToBytes(pcm, binary.BigEndian)
+		assert.Equal(t, []byte{0x00, 0x01, 0xFF, 0xFE, 0x12, 0x34}, b)
+		assert.Equal(t, pcm, audio.BytesToPCM16(b, binary.BigEndian))
+	})
+
+	t.Run("Odd trailing byte", func(t *testing.T) {
+		assert.Equal(t, []int16{1}, audio.BytesToPCM16([]byte{0x01, 0x00, 0x05}, binary.LittleEndian))
+	})
+
+	t.Run("Into reuses buffers", func(t *testing.T) {
+		buf := make([]byte, 16)
+		n := audio.PCM16ToBytesInto(buf, pcm, binary.LittleEndian)
+		assert.Equal(t, 6, n)
+
+		samples := make([]int16, 8)
+		n = audio.BytesToPCM16Into(samples, buf[:n], binary.LittleEndian)
+		assert.Equal(t, pcm, samples[:n])
+	})
+}
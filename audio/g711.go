@@ -0,0 +1,213 @@
+package audio
+
+import "encoding/binary"
+
+// G.711 companding constants
+const (
+	ulawBias = 0x84
+	ulawClip = 32635
+)
+
+// alawSegmentEnds are the upper bounds of each A-law segment for 13-bit samples
+var alawSegmentEnds = [8]int{0x1F, 0x3F, 0x7F, 0xFF, 0x1FF, 0x3FF, 0x7FF, 0xFFF}
+
+// Decoding tables, built once since there are only 256 possible codes
+var (
+	ulawDecodeTable [256]int16
+	alawDecodeTable [256]int16
+)
+
+func init() {
+	for i := range ulawDecodeTable {
+		ulawDecodeTable[i] = decodeUlawSample(byte(i))
+		alawDecodeTable[i] = decodeAlawSample(byte(i))
+	}
+}
+
+// EncodeUlaw compresses 16-bit PCM samples to G.711 µ-law
+func EncodeUlaw(pcm []int16) []byte {
+	dst := make([]byte, len(pcm))
+	EncodeUlawInto(dst, pcm)
+	return dst
+}
+
+// EncodeUlawInto compresses pcm into dst, which must hold at least len(pcm)
+// bytes, and returns the number of bytes written
+func EncodeUlawInto(dst []byte, pcm []int16) int {
+	for i, s := range pcm {
+		dst[i] = encodeUlawSample(s)
+	}
+	return len(pcm)
+}
+
+// DecodeUlaw expands G.711 µ-law bytes to 16-bit PCM samples
+func DecodeUlaw(b []byte) []int16 {
+	dst := make([]int16, len(b))
+	DecodeUlawInto(dst, b)
+	return dst
+}
+
+// DecodeUlawInto expands b into dst, which must hold at least len(b) samples,
+// and returns the number of samples written
+func DecodeUlawInto(dst []int16, b []byte) int {
+	for i, u := range b {
+		dst[i] = ulawDecodeTable[u]
+	}
+	return len(b)
+}
+
+// EncodeAlaw compresses 16-bit PCM samples to G.711 A-law
+func EncodeAlaw(pcm []int16) []byte {
+	dst := make([]byte, len(pcm))
+	EncodeAlawInto(dst, pcm)
+	return dst
+}
+
+// EncodeAlawInto compresses pcm into dst, which must hold at least len(pcm)
+// bytes, and returns the number of bytes written
+func EncodeAlawInto(dst []byte, pcm []int16) int {
+	for i, s := range pcm {
+		dst[i] = encodeAlawSample(s)
+	}
+	return len(pcm)
+}
+
+// DecodeAlaw expands G.711 A-law bytes to 16-bit PCM samples
+func DecodeAlaw(b []byte) []int16 {
+	dst := make([]int16, len(b))
+	DecodeAlawInto(dst, b)
+	return dst
+}
+
+// DecodeAlawInto expands b into dst, which must hold at least len(b) samples,
+// and returns the number of samples written
+func DecodeAlawInto(dst []int16, b []byte) int {
+	for i, a := range b {
+		dst[i] = alawDecodeTable[a]
+	}
+	return len(b)
+}
+
+// encodeUlawSample compresses a single sample to µ-law
+func encodeUlawSample(s int16) byte {
+	sample := int(s)
+	sign := 0
+	if sample < 0 {
+		sample = -sample
+		sign = 0x80
+	}
+	if sample > ulawClip {
+		sample = ulawClip
+	}
+	sample += ulawBias
+
+	exponent := 7
+	for mask := 0x4000; sample&mask == 0 && exponent > 0; mask >>= 1 {
+		exponent--
+	}
+	mantissa := (sample >> (exponent + 3)) & 0x0F
+
+	return ^byte(sign | exponent<<4 | mantissa)
+}
+
+// decodeUlawSample expands a single µ-law code
+func decodeUlawSample(u byte) int16 {
+	u = ^u
+	exponent := int(u>>4) & 0x07
+	mantissa := int(u & 0x0F)
+
+	sample := ((mantissa << 3) + ulawBias) << exponent
+	sample -= ulawBias
+
+	if u&0x80 != 0 {
+		return int16(-sample)
+	}
+	return int16(sample)
+}
+
+// encodeAlawSample compresses a single sample to A-law
+func encodeAlawSample(s int16) byte {
+	pcm := int(s) >> 3
+
+	mask := 0xD5
+	if pcm < 0 {
+		mask = 0x55
+		pcm = -pcm - 1
+	}
+
+	seg := 0
+	for seg < len(alawSegmentEnds) && pcm > alawSegmentEnds[seg] {
+		seg++
+	}
+	if seg >= len(alawSegmentEnds) {
+		return byte(0x7F ^ mask)
+	}
+
+	aval := seg << 4
+	if seg < 2 {
+		aval |= (pcm >> 1) & 0x0F
+	} else {
+		aval |= (pcm >> seg) & 0x0F
+	}
+	return byte(aval ^ mask)
+}
+
+// decodeAlawSample expands a single A-law code
+func decodeAlawSample(a byte) int16 {
+	a ^= 0x55
+
+	t := int(a&0x0F) << 4
+	switch seg := int(a&0x70) >> 4; seg {
+	case 0:
+		t += 8
+	case 1:
+		t += 0x108
+	default:
+		t += 0x108
+		t <<= seg - 1
+	}
+
+	if a&0x80 != 0 {
+		return int16(t)
+	}
+	return int16(-t)
+}
+
+// UlawToPCM16LE expands G.711 µ-law straight to little-endian 16-bit PCM bytes,
+// the format used for Ultravox WebSocket audio
+func UlawToPCM16LE(b []byte) []byte {
+	dst := make([]byte, len(b)*2)
+	for i, u := range b {
+		binary.LittleEndian.PutUint16(dst[i*2:], uint16(ulawDecodeTable[u]))
+	}
+	return dst
+}
+
+// AlawToPCM16LE expands G.711 A-law straight to little-endian 16-bit PCM bytes
+func AlawToPCM16LE(b []byte) []byte {
+	dst := make([]byte, len(b)*2)
+	for i, a := range b {
+		binary.LittleEndian.PutUint16(dst[i*2:], uint16(alawDecodeTable[a]))
+	}
+	return dst
+}
+
+// PCM16LEToUlaw compresses little-endian 16-bit PCM bytes straight to G.711 µ-law.
+// A trailing odd byte is ignored.
+func PCM16LEToUlaw(pcm []byte) []byte {
+	dst := make([]byte, len(pcm)/2)
+	for i := range dst {
+		dst[i] = encodeUlawSample(int16(binary.LittleEndian.Uint16(pcm[i*2:])))
+	}
+	return dst
+}
+
+// PCM16LEToAlaw compresses little-endian 16-bit PCM bytes straight to G.711 A-law.
+// A trailing odd byte is ignored.
+func PCM16LEToAlaw(pcm []byte) []byte {
+	dst := make([]byte, len(pcm)/2)
+	for i := range dst {
+		dst[i] = encodeAlawSample(int16(binary.LittleEndian.Uint16(pcm[i*2:])))
+	}
+	return dst
+}
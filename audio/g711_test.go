@@ -0,0 +1,119 @@
+package audio_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/paulgrammer/ultravox/audio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUlaw(t *testing.T) {
+	tests := []struct {
+		name    string
+		sample  int16
+		code    byte
+		decoded int16
+	}{
+		{name: "Silence", sample: 0, code: 0xFF, decoded: 0},
+		{name: "Positive full scale", sample: 32767, code: 0x80, decoded: 32124},
+		{name: "Negative full scale", sample: -32768, code: 0x00, decoded: -32124},
+		{name: "Small positive", sample: 100, code: 0xF2, decoded: 104},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, []byte{tt.code}, audio.EncodeUlaw([]int16{tt.sample}))
+			assert.Equal(t, []int16{tt.decoded}, audio.DecodeUlaw([]byte{tt.code}))
+		})
+	}
+}
+
+func TestAlaw(t *testing.T) {
+	tests := []struct {
+		name    string
+		sample  int16
+		code    byte
+		decoded int16
+	}{
+		{name: "Silence", sample: 0, code: 0xD5, decoded: 8},
+		{name: "Positive full scale", sample: 32767, code: 0xAA, decoded: 32256},
+		{name: "Negative full scale", sample: -32768, code: 0x2A, decoded: -32256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, []byte{tt.code}, audio.EncodeAlaw([]int16{tt.sample}))
+			assert.Equal(t, []int16{tt.decoded}, audio.DecodeAlaw([]byte{tt.code}))
+		})
+	}
+}
+
+func TestG711RoundTrip(t *testing.T) {
+	codes := make([]byte, 256)
+	for i := range codes {
+		codes[i] = byte(i)
+	}
+
+	t.Run("Ulaw", func(t *testing.T) {
+		got := audio.EncodeUlaw(audio.DecodeUlaw(codes))
+		for i, c := range codes {
+			// 0x7F is negative zero, which re-encodes as positive zero
+			if c == 0x7F {
+				continue
+			}
+			assert.Equal(t, c, got[i], "code %#x", c)
+		}
+	})
+
+	t.Run("Alaw", func(t *testing.T) {
+		assert.Equal(t, codes, audio.EncodeAlaw(audio.DecodeAlaw(codes)))
+	})
+
+	t.Run("Quantisation error", func(t *testing.T) {
+		for s := -32768; s <= 32767; s += 97 {
+			sample := int16(s)
+			ulaw := audio.DecodeUlaw(audio.EncodeUlaw([]int16{sample}))[0]
+			alaw := audio.DecodeAlaw(audio.EncodeAlaw([]int16{sample}))[0]
+
+			// Companding keeps the error roughly proportional to the sample's magnitude
+			tolerance := abs(int(sample))/16 + 16
+			assert.LessOrEqual(t, abs(int(ulaw)-int(sample)), tolerance, "ulaw %d", s)
+			assert.LessOrEqual(t, abs(int(alaw)-int(sample)), tolerance, "alaw %d", s)
+		}
+	})
+}
+
+func TestEncodeUlawInto(t *testing.T) {
+	pcm := []int16{0, 100, -100, 32767}
+	dst := make([]byte, 8)
+
+	n := audio.EncodeUlawInto(dst, pcm)
+	assert.Equal(t, len(pcm), n)
+	assert.Equal(t, audio.EncodeUlaw(pcm), dst[:n])
+
+	samples := make([]int16, 8)
+	n = audio.DecodeUlawInto(samples, dst[:n])
+	assert.Equal(t, len(pcm), n)
+	assert.Equal(t, audio.DecodeUlaw(dst[:n]), samples[:n])
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func TestPCM16LEConversions(t *testing.T) {
+	pcm := []int16{0, 1000, -1000, 32767, -32768}
+	pcmBytes := audio.PCM16ToBytes(pcm, binary.LittleEndian)
+
+	ulaw := audio.EncodeUlaw(pcm)
+	assert.Equal(t, ulaw, audio.PCM16LEToUlaw(pcmBytes))
+	assert.Equal(t, audio.PCM16ToBytes(audio.DecodeUlaw(ulaw), binary.LittleEndian), audio.UlawToPCM16LE(ulaw))
+
+	alaw := audio.EncodeAlaw(pcm)
+	assert.Equal(t, alaw, audio.PCM16LEToAlaw(pcmBytes))
+	assert.Equal(t, audio.PCM16ToBytes(audio.DecodeAlaw(alaw), binary.LittleEndian), audio.AlawToPCM16LE(alaw))
+}
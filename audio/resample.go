@@ -0,0 +1,43 @@
+package audio
+
+import "fmt"
+
+// Resample converts mono 16-bit PCM from inRate to outRate using linear
+// interpolation, returning len(pcm)*outRate/inRate samples. Downsampling does
+// not low-pass filter first, which is adequate for speech between the 8k, 16k
+// and 24k rates used with Ultravox. Resample panics if either rate is not positive.
+func Resample(pcm []int16, inRate, outRate int) []int16 {
+	if inRate <= 0 || outRate <= 0 {
+		panic(fmt.Sprintf("audio: invalid sample rates %d -> %d", inRate, outRate))
+	}
+
+	if inRate == outRate {
+		out := make([]int16, len(pcm))
+		copy(out, pcm)
+		return out
+	}
+
+	outLen := len(pcm) * outRate / inRate
+	out := make([]int16, outLen)
+	if len(pcm) == 0 {
+		return out
+	}
+
+	last := len(pcm) - 1
+	for i := range out {
+		// Position in the input, as a whole sample index plus a fraction rem/outRate
+		pos := int64(i) * int64(inRate)
+		idx := int(pos / int64(outRate))
+		rem := pos % int64(outRate)
+
+		if idx >= last {
+			out[i] = pcm[last]
+			continue
+		}
+
+		a, b := int64(pcm[idx]), int64(pcm[idx+1])
+		out[i] = int16((a*(int64(outRate)-rem) + b*rem) / int64(outRate))
+	}
+
+	return out
+}
@@ -0,0 +1,60 @@
+package audio_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulgrammer/ultravox/audio"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResample(t *testing.T) {
+	tests := []struct {
+		name    string
+		inLen   int
+		inRate  int
+		outRate int
+	}{
+		{name: "8k to 16k", inLen: 160, inRate: 8000, outRate: 16000},
+		{name: "8k to 24k", inLen: 160, inRate: 8000, outRate: 24000},
+		{name: "16k to 8k", inLen: 320, inRate: 16000, outRate: 8000},
+		{name: "24k to 16k", inLen: 480, inRate: 24000, outRate: 16000},
+		{name: "Uneven length", inLen: 101, inRate: 16000, outRate: 24000},
+		{name: "Same rate", inLen: 80, inRate: 8000, outRate: 8000},
+		{name: "Empty input", inLen: 0, inRate: 8000, outRate: 16000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcm := sine(tt.inLen, tt.inRate, 440)
+			out := audio.Resample(pcm, tt.inRate, tt.outRate)
+
+			assert.InDelta(t, float64(tt.inLen*tt.outRate)/float64(tt.inRate), float64(len(out)), 1)
+
+			// The resampled signal should track the same sine wave at the new rate
+			want := sine(len(out), tt.outRate, 440)
+			for i := range out {
+				assert.InDelta(t, want[i], out[i], 1500, "sample %d", i)
+			}
+		})
+	}
+}
+
+func TestResample_Interpolates(t *testing.T) {
+	out := audio.Resample([]int16{0, 100, 200}, 1, 2)
+	assert.Equal(t, []int16{0, 50, 100, 150, 200, 200}, out)
+}
+
+func TestResample_InvalidRates(t *testing.T) {
+	assert.Panics(t, func() { audio.Resample([]int16{1}, 0, 8000) })
+	assert.Panics(t, func() { audio.Resample([]int16{1}, 8000, -1) })
+}
+
+// sine generates n samples of a tone at freq Hz sampled at rate Hz
+func sine(n, rate int, freq float64) []int16 {
+	pcm := make([]int16, n)
+	for i := range pcm {
+		pcm[i] = int16(10000 * math.Sin(2*math.Pi*freq*float64(i)/float64(rate)))
+	}
+	return pcm
+}
@@ -0,0 +1,127 @@
+package ultravox
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// CallBuilder is a chainable alternative to passing CallOptions to Call. Each
+// setter records the matching WithCall option, so a built call is configured
+// exactly as if those options had been passed directly.
+type CallBuilder struct {
+	opts []CallOption
+}
+
+// NewCallBuilder returns an empty call builder
+func NewCallBuilder() *CallBuilder {
+	return &CallBuilder{}
+}
+
+// With adds options that have no dedicated setter
+func (b *CallBuilder) With(opts ...CallOption) *CallBuilder {
+	b.opts = append(b.opts, opts...)
+	return b
+}
+
+// SetSystemPrompt sets the system prompt, as WithCallSystemPrompt does
+func (b *CallBuilder) SetSystemPrompt(prompt string) *CallBuilder {
+	return b.With(WithCallSystemPrompt(prompt))
+}
+
+// SetTemperature sets the model temperature, as WithCallTemperature does
+func (b *CallBuilder) SetTemperature(temperature float64) *CallBuilder {
+	return b.With(WithCallTemperature(temperature))
+}
+
+// SetModel sets the model, as WithCallModel does
+func (b *CallBuilder) SetModel(model string) *CallBuilder {
+	return b.With(WithCallModel(model))
+}
+
+// SetVoice sets the voice, as WithCallVoice does
+func (b *CallBuilder) SetVoice(voice string) *CallBuilder {
+	return b.With(WithCallVoice(voice))
+}
+
+// SetExternalVoice sets an external voice, as WithCallExternalVoice does
+func (b *CallBuilder) SetExternalVoice(voice *ExternalVoice) *CallBuilder {
+	return b.With(WithCallExternalVoice(voice))
+}
+
+// SetLanguageHint sets the language hint, as WithCallLanguageHint does
+func (b *CallBuilder) SetLanguageHint(languageHint string) *CallBuilder {
+	return b.With(WithCallLanguageHint(languageHint))
+}
+
+// SetJoinTimeout sets the join timeout, as WithCallJoinTimeout does
+func (b *CallBuilder) SetJoinTimeout(timeout time.Duration) *CallBuilder {
+	return b.With(WithCallJoinTimeout(timeout))
+}
+
+// SetMaxDuration sets the maximum call duration, as WithCallMaxDuration does
+func (b *CallBuilder) SetMaxDuration(duration time.Duration) *CallBuilder {
+	return b.With(WithCallMaxDuration(duration))
+}
+
+// SetMedium sets the call medium, as WithCallMedium does
+func (b *CallBuilder) SetMedium(medium *CallMedium) *CallBuilder {
+	return b.With(WithCallMedium(medium))
+}
+
+// SetFirstSpeakerSettings sets who speaks first, as WithCallFirstSpeakerSettings does
+func (b *CallBuilder) SetFirstSpeakerSettings(settings *FirstSpeakerSettings) *CallBuilder {
+	return b.With(WithCallFirstSpeakerSettings(settings))
+}
+
+// SetInitialOutputMedium sets the initial output medium, as WithCallInitialOutputMedium does
+func (b *CallBuilder) SetInitialOutputMedium(medium OutputMediumType) *CallBuilder {
+	return b.With(WithCallInitialOutputMedium(medium))
+}
+
+// SetInitialMessages sets the conversation history, as WithCallInitialMessages does
+func (b *CallBuilder) SetInitialMessages(messages []Message) *CallBuilder {
+	return b.With(WithCallInitialMessages(messages))
+}
+
+// SetInactivityMessages sets the inactivity messages, as WithCallInactivityMessages does
+func (b *CallBuilder) SetInactivityMessages(messages []TimedMessage) *CallBuilder {
+	return b.With(WithCallInactivityMessages(messages))
+}
+
+// SetVadSettings sets the voice activity detection settings, as WithCallVadSettings does
+func (b *CallBuilder) SetVadSettings(settings *VadSettings) *CallBuilder {
+	return b.With(WithCallVadSettings(settings))
+}
+
+// SetRecordingEnabled turns recording on or off, as WithCallRecordingEnabled does
+func (b *CallBuilder) SetRecordingEnabled(enabled bool) *CallBuilder {
+	return b.With(WithCallRecordingEnabled(enabled))
+}
+
+// SetMetadata sets the call metadata, as WithCallMetadata does
+func (b *CallBuilder) SetMetadata(metadata map[string]string) *CallBuilder {
+	return b.With(WithCallMetadata(metadata))
+}
+
+// SetDataConnection sets the data connection, as WithCallDataConnection does
+func (b *CallBuilder) SetDataConnection(config *DataConnectionConfig) *CallBuilder {
+	return b.With(WithCallDataConnection(config))
+}
+
+// AddTool adds a tool selection to the call
+func (b *CallBuilder) AddTool(tool SelectedTool) *CallBuilder {
+	return b.With(func(r *CallRequest) {
+		r.SelectedTools = append(r.SelectedTools, tool)
+	})
+}
+
+// Build returns the recorded options in the order they were set
+func (b *CallBuilder) Build() []CallOption {
+	return slices.Clone(b.opts)
+}
+
+// Call creates the call on client, as client.Call(ctx, b.Build()...) does
+func (b *CallBuilder) Call(ctx context.Context, client *Client) (*Call, error) {
+	return client.Call(ctx, b.Build()...)
+}
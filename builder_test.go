@@ -0,0 +1,70 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallBuilder(t *testing.T) {
+	var bodies []string
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	})
+
+	builder := ultravox.NewCallBuilder().
+		SetSystemPrompt("You are a receptionist.").
+		SetModel("fixie-ai/ultravox-70B").
+		SetVoice("Jessica").
+		SetTemperature(0.4).
+		SetMaxDuration(3 * time.Minute).
+		SetVadSettings(ultravox.NewVadSettings()).
+		SetMetadata(map[string]string{"team": "support"}).
+		AddTool(ultravox.SelectedTool{ToolName: "hangUp"}).
+		With(ultravox.WithCallWebRTCMedium())
+
+	call, err := builder.Call(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, "call-123", call.CallID)
+
+	_, err = client.Call(context.Background(),
+		ultravox.WithCallSystemPrompt("You are a receptionist."),
+		ultravox.WithCallModel("fixie-ai/ultravox-70B"),
+		ultravox.WithCallVoice("Jessica"),
+		ultravox.WithCallTemperature(0.4),
+		ultravox.WithCallMaxDuration(3*time.Minute),
+		ultravox.WithCallVadSettings(ultravox.NewVadSettings()),
+		ultravox.WithCallMetadata(map[string]string{"team": "support"}),
+		ultravox.WithCallToolByName("hangUp"),
+		ultravox.WithCallWebRTCMedium(),
+	)
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 2)
+	assert.JSONEq(t, bodies[1], bodies[0], "the builder sends the same request as the equivalent options")
+
+	t.Run("Build returns a copy", func(t *testing.T) {
+		builder := ultravox.NewCallBuilder().SetVoice("Mark")
+		opts := builder.Build()
+		builder.SetModel("other")
+
+		assert.Len(t, opts, 1)
+		assert.Len(t, builder.Build(), 2)
+	})
+}
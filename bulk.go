@@ -0,0 +1,95 @@
+package ultravox
+
+import (
+	"context"
+	"sync"
+)
+
+// WithBulkConcurrency limits BulkCall to limit in-flight call creations
+func WithBulkConcurrency(limit int) Option {
+	return func(c *Config) {
+		c.BulkConcurrency = limit
+	}
+}
+
+// BulkCall creates n calls concurrently with the same options. Results are
+// returned in slot order: calls[i] is set when errs[i] is nil, so callers can
+// tell which calls succeeded. Requests still honour the client's rate limiter
+// and retry policy, and slots not yet started when ctx is done fail with
+// ctx.Err(). No calls are created when n is not positive.
+func (c *Client) BulkCall(ctx context.Context, n int, opts ...CallOption) ([]*Call, []error) {
+	n = max(n, 0)
+	calls := make([]*Call, n)
+	errs := make([]error, n)
+
+	started := forEachConcurrently(ctx, n, c.config.BulkConcurrency, func(i int) {
+		calls[i], errs[i] = c.Call(ctx, opts...)
+	})
+	for i := started; i < n; i++ {
+		errs[i] = ctx.Err()
+	}
+	return calls, errs
+}
+
+// CallSpec describes one call created by CallBatch
+type CallSpec struct {
+	Options []CallOption
+}
+
+// CallResult is the outcome of one CallSpec: Call is set when Err is nil
+type CallResult struct {
+	Spec CallSpec
+	Call *Call
+	Err  error
+}
+
+// CallBatch creates a call for each spec, with up to concurrency creations in
+// flight, or all of them when concurrency is not positive. Results are in the
+// order of specs. When ctx is done before every spec has started, the partial
+// results are returned along with ctx.Err(), and specs not started fail with
+// it too.
+func (c *Client) CallBatch(ctx context.Context, specs []CallSpec, concurrency int) ([]CallResult, error) {
+	results := make([]CallResult, len(specs))
+	for i, spec := range specs {
+		results[i].Spec = spec
+	}
+
+	started := forEachConcurrently(ctx, len(specs), concurrency, func(i int) {
+		results[i].Call, results[i].Err = c.Call(ctx, specs[i].Options...)
+	})
+	if started == len(specs) {
+		return results, nil
+	}
+	for i := started; i < len(specs); i++ {
+		results[i].Err = ctx.Err()
+	}
+	return results, ctx.Err()
+}
+
+// forEachConcurrently calls fn for each index below n, with at most limit
+// calls in flight, or n when limit is not positive. It stops starting calls
+// once ctx is done, waits for those in flight and returns how many started.
+func forEachConcurrently(ctx context.Context, n, limit int, fn func(i int)) int {
+	if limit <= 0 || limit > n {
+		limit = n
+	}
+	sem := make(chan struct{}, limit)
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for i := range n {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return i
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}()
+	}
+	return n
+}
@@ -0,0 +1,199 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_BulkCall(t *testing.T) {
+	var created, inFlight, maxInFlight atomic.Int32
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			cur := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				prev := maxInFlight.Load()
+				if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			id := created.Add(1)
+			if id == 3 {
+				return &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Invalid voice"}`)),
+				}, nil
+			}
+
+			body := fmt.Sprintf(`{"callId": "call-%d", "joinUrl": "wss://example.com/join/call-%d"}`, id, id)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithBulkConcurrency(2))
+	client.WithHTTPClient(mockClient)
+
+	calls, errs := client.BulkCall(context.Background(), 6, ultravox.WithCallVoice("Mark"))
+	require.Len(t, calls, 6)
+	require.Len(t, errs, 6)
+
+	var failed int
+	for i := range calls {
+		if errs[i] != nil {
+			failed++
+			assert.Nil(t, calls[i])
+
+			var apiErr *ultravox.APIError
+			require.True(t, errors.As(errs[i], &apiErr))
+			assert.Equal(t, http.StatusUnprocessableEntity, apiErr.StatusCode)
+			continue
+		}
+		require.NotNil(t, calls[i])
+		assert.NotEmpty(t, calls[i].JoinURL)
+	}
+
+	assert.Equal(t, 1, failed)
+	assert.Equal(t, int32(6), created.Load())
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestClient_BulkCall_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			cancel()
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithBulkConcurrency(1))
+	client.WithHTTPClient(mockClient)
+
+	calls, errs := client.BulkCall(ctx, 4)
+	for i := range calls {
+		assert.Nil(t, calls[i])
+		assert.ErrorIs(t, errs[i], context.Canceled)
+	}
+}
+
+func TestClient_BulkCall_NonPositive(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no call should be created")
+			return nil, nil
+		},
+	})
+
+	for _, n := range []int{0, -1} {
+		calls, errs := client.BulkCall(context.Background(), n)
+		assert.Empty(t, calls)
+		assert.Empty(t, errs)
+	}
+}
+
+func TestClient_CallBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			cur := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				prev := maxInFlight.Load()
+				if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+
+			var body struct {
+				Metadata map[string]string `json:"metadata"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				return nil, err
+			}
+			lead := body.Metadata["lead"]
+			if lead == "2" {
+				return &http.Response{
+					StatusCode: http.StatusUnprocessableEntity,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Invalid number"}`)),
+				}, nil
+			}
+
+			// Later leads answer sooner, so completion order differs from input order
+			n, _ := strconv.Atoi(lead)
+			time.Sleep(time.Duration(10-n) * time.Millisecond)
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-` + lead + `", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	specs := make([]ultravox.CallSpec, 6)
+	for i := range specs {
+		specs[i] = ultravox.CallSpec{Options: []ultravox.CallOption{
+			ultravox.WithCallMetadata(map[string]string{"lead": fmt.Sprint(i)}),
+		}}
+	}
+
+	results, err := client.CallBatch(context.Background(), specs, 3)
+	require.NoError(t, err)
+	require.Len(t, results, 6)
+	for i, result := range results {
+		assert.Len(t, result.Spec.Options, 1)
+		if i == 2 {
+			assert.Nil(t, result.Call)
+			assert.ErrorContains(t, result.Err, "Invalid number")
+			continue
+		}
+		require.NoError(t, result.Err)
+		assert.Equal(t, fmt.Sprintf("call-%d", i), result.Call.CallID)
+	}
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(3))
+
+	t.Run("Context canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				cancel()
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			},
+		})
+
+		results, err := client.CallBatch(ctx, specs, 1)
+		assert.ErrorIs(t, err, context.Canceled)
+		require.Len(t, results, 6)
+		for _, result := range results {
+			assert.Nil(t, result.Call)
+			assert.ErrorIs(t, result.Err, context.Canceled)
+		}
+	})
+}
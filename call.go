@@ -1,6 +1,17 @@
 package ultravox
 
-import "time"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+)
 
 type TemplateContext struct {
 	UserFirstname      string `json:"userFirstname,omitempty" yaml:"userFirstname,omitempty"`
@@ -48,6 +59,19 @@ type CallRequest struct {
 	// For Agent Calls
 	AgentID         string           `json:"-" yaml:"-"`
 	TemplateContext *TemplateContext `json:"templateContext,omitempty" yaml:"templateContext,omitempty"`
+
+	// httpHeaders are extra headers sent with the request that creates the call
+	httpHeaders http.Header
+
+	// lenientLanguageHint skips the BCP-47 check on LanguageHint in Validate
+	lenientLanguageHint bool
+
+	// dynamicSystemPrompt, when set, computes SystemPrompt as the call is created
+	dynamicSystemPrompt func(context.Context) (string, error)
+
+	// optionErr records the first error raised while applying a CallOption,
+	// reported by Call before any request is sent
+	optionErr error
 }
 
 // Call contains the response from a call creation request
@@ -69,11 +93,293 @@ type Call struct {
 	ErrorCount           int                   `json:"errorCount" yaml:"errorCount"`
 	ShortSummary         string                `json:"shortSummary,omitempty" yaml:"shortSummary,omitempty"`
 	Summary              string                `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Metadata             map[string]string     `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	InitialState         interface{}           `json:"initialState,omitempty" yaml:"initialState,omitempty"`
+}
+
+// IsActive reports whether the call has been joined and has not yet ended
+func (c *Call) IsActive() bool {
+	return c.Joined != "" && c.Ended == ""
+}
+
+// Duration returns how long the call lasted, from when it was joined until it
+// ended. It returns ErrCallNotEnded while the call is still in progress.
+func (c *Call) Duration() (time.Duration, error) {
+	if c.Ended == "" {
+		return 0, ErrCallNotEnded
+	}
+	if c.Joined == "" {
+		return 0, errors.New("call was never joined")
+	}
+
+	joined, err := parseCallTime(c.Joined)
+	if err != nil {
+		return 0, fmt.Errorf("joined: %w", err)
+	}
+	ended, err := parseCallTime(c.Ended)
+	if err != nil {
+		return 0, fmt.Errorf("ended: %w", err)
+	}
+	return ended.Sub(joined), nil
+}
+
+// Age returns the time elapsed since the call was created
+func (c *Call) Age() (time.Duration, error) {
+	created, err := parseCallTime(c.Created)
+	if err != nil {
+		return 0, fmt.Errorf("created: %w", err)
+	}
+	return time.Since(created), nil
+}
+
+// parseCallTime parses an RFC 3339 timestamp returned by the API
+func parseCallTime(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q, expected RFC 3339", s)
+	}
+	return t, nil
+}
+
+// CallSummary is the summary of a call returned by GetCallSummary
+type CallSummary struct {
+	Short    string           `json:"short" yaml:"short"`
+	Full     string           `json:"full" yaml:"full"`
+	Duration UltravoxDuration `json:"duration" yaml:"duration"`
+}
+
+// CallPage is a single page of calls returned by ListCalls
+type CallPage struct {
+	Results  []Call `json:"results"`
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+	Total    int    `json:"total,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *CallPage) NextCursor() string {
+	return cursorFromURL(p.Next)
+}
+
+// MessagePage is a single page of a call's messages returned by ListCallMessages
+type MessagePage struct {
+	Results  []Message `json:"results"`
+	Next     string    `json:"next,omitempty"`
+	Previous string    `json:"previous,omitempty"`
+	Total    int       `json:"total,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *MessagePage) NextCursor() string {
+	return cursorFromURL(p.Next)
+}
+
+// Validate checks the request for contradictory or out-of-range settings.
+// All violations are collected and returned together as a joined error.
+func (r *CallRequest) Validate() error {
+	var errs []error
+
+	if r.Temperature < 0 || r.Temperature > 2 {
+		errs = append(errs, fmt.Errorf("temperature must be between 0 and 2, got %g", r.Temperature))
+	}
+	if r.MaxDuration < 0 {
+		errs = append(errs, fmt.Errorf("maxDuration must not be negative, got %s", r.MaxDuration))
+	}
+	if r.JoinTimeout < 0 {
+		errs = append(errs, fmt.Errorf("joinTimeout must not be negative, got %s", r.JoinTimeout))
+	}
+	if r.LanguageHint != "" && !r.lenientLanguageHint {
+		if _, err := language.Parse(r.LanguageHint); err != nil {
+			errs = append(errs, fmt.Errorf("languageHint %q is not a valid BCP-47 tag", r.LanguageHint))
+		}
+	}
+	if r.FirstSpeaker != "" && r.FirstSpeakerSettings != nil {
+		errs = append(errs, errors.New("firstSpeaker and firstSpeakerSettings cannot both be set"))
+	}
+	for i, m := range r.InactivityMessages {
+		if m.Duration <= 0 {
+			errs = append(errs, fmt.Errorf("inactivityMessages[%d]: duration must be positive, got %s", i, m.Duration))
+		}
+	}
+	for i, tool := range r.SelectedTools {
+		if tool.TemporaryTool == nil {
+			continue
+		}
+		if err := tool.TemporaryTool.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("selectedTools[%d]: temporaryTool: %w", i, err))
+		}
+	}
+	if r.Medium != nil {
+		if err := r.Medium.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("medium: %w", err))
+		}
+	}
+	if r.DataConnection != nil {
+		if err := r.DataConnection.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("dataConnection: %w", err))
+		}
+	}
+	if r.VadSettings != nil {
+		if err := r.VadSettings.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("vadSettings: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// clone returns a deep copy of the request. Values held in interface{} fields
+// such as InitialState and untyped ExperimentalSettings are shared with r.
+func (r CallRequest) clone() CallRequest {
+	c := r
+	switch settings := r.ExperimentalSettings.(type) {
+	case ExperimentalSettings:
+		settings.Raw = maps.Clone(settings.Raw)
+		c.ExperimentalSettings = settings
+	case *ExperimentalSettings:
+		if settings != nil {
+			settings := *settings
+			settings.Raw = maps.Clone(settings.Raw)
+			c.ExperimentalSettings = &settings
+		}
+	}
+	c.ExternalVoice = r.ExternalVoice.clone()
+	c.InitialMessages = slices.Clone(r.InitialMessages)
+	for i := range c.InitialMessages {
+		c.InitialMessages[i].Timespan = clonePtr(c.InitialMessages[i].Timespan)
+	}
+	c.InactivityMessages = slices.Clone(r.InactivityMessages)
+	c.SelectedTools = cloneSelectedTools(r.SelectedTools)
+	c.Medium = r.Medium.clone()
+	c.FirstSpeakerSettings = r.FirstSpeakerSettings.clone()
+	c.VadSettings = clonePtr(r.VadSettings)
+	c.Metadata = maps.Clone(r.Metadata)
+	c.DataConnection = r.DataConnection.clone()
+	c.TemplateContext = clonePtr(r.TemplateContext)
+	c.httpHeaders = r.httpHeaders.Clone()
+	return c
+}
+
+// merge overlays the non-zero fields of other onto r. Slices are appended and
+// maps are merged key by key, with other taking precedence.
+func (r *CallRequest) merge(other CallRequest) {
+	other = other.clone()
+
+	if other.SystemPrompt != "" {
+		r.SystemPrompt = other.SystemPrompt
+	}
+	if other.Temperature != 0 {
+		r.Temperature = other.Temperature
+	}
+	if other.Model != "" {
+		r.Model = other.Model
+	}
+	if other.Voice != "" {
+		r.Voice = other.Voice
+	}
+	if other.ExternalVoice != nil {
+		r.ExternalVoice = other.ExternalVoice
+	}
+	if other.LanguageHint != "" {
+		r.LanguageHint = other.LanguageHint
+	}
+	r.InitialMessages = append(r.InitialMessages, other.InitialMessages...)
+	if other.JoinTimeout != 0 {
+		r.JoinTimeout = other.JoinTimeout
+	}
+	if other.MaxDuration != 0 {
+		r.MaxDuration = other.MaxDuration
+	}
+	if other.TimeExceededMessage != "" {
+		r.TimeExceededMessage = other.TimeExceededMessage
+	}
+	r.InactivityMessages = append(r.InactivityMessages, other.InactivityMessages...)
+	r.SelectedTools = append(r.SelectedTools, other.SelectedTools...)
+	if other.Medium != nil {
+		r.Medium = other.Medium
+	}
+	if other.RecordingEnabled {
+		r.RecordingEnabled = true
+	}
+	if other.FirstSpeaker != "" {
+		r.FirstSpeaker = other.FirstSpeaker
+	}
+	if other.InitialOutputMedium != "" {
+		r.InitialOutputMedium = other.InitialOutputMedium
+	}
+	if other.FirstSpeakerSettings != nil {
+		r.FirstSpeakerSettings = other.FirstSpeakerSettings
+	}
+	if other.VadSettings != nil {
+		r.VadSettings = other.VadSettings
+	}
+	if other.ExperimentalSettings != nil {
+		r.ExperimentalSettings = other.ExperimentalSettings
+	}
+	if len(other.Metadata) > 0 {
+		if r.Metadata == nil {
+			r.Metadata = make(map[string]string, len(other.Metadata))
+		}
+		maps.Copy(r.Metadata, other.Metadata)
+	}
+	if other.InitialState != nil {
+		r.InitialState = other.InitialState
+	}
+	if other.DataConnection != nil {
+		r.DataConnection = other.DataConnection
+	}
+	if other.PriorCallId != "" {
+		r.PriorCallId = other.PriorCallId
+	}
+	if other.EnableGreetingPrompt {
+		r.EnableGreetingPrompt = true
+	}
+	if other.AgentID != "" {
+		r.AgentID = other.AgentID
+	}
+	if other.TemplateContext != nil {
+		r.TemplateContext = other.TemplateContext
+	}
+	if len(other.httpHeaders) > 0 {
+		if r.httpHeaders == nil {
+			r.httpHeaders = make(http.Header, len(other.httpHeaders))
+		}
+		maps.Copy(r.httpHeaders, other.httpHeaders)
+	}
+	if other.lenientLanguageHint {
+		r.lenientLanguageHint = true
+	}
+	if other.dynamicSystemPrompt != nil {
+		r.dynamicSystemPrompt = other.dynamicSystemPrompt
+	}
+	if r.optionErr == nil {
+		r.optionErr = other.optionErr
+	}
+}
+
+// clonePtr returns a pointer to a shallow copy of *p, or nil if p is nil
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
 }
 
 // CallOption defines a function that modifies a call request
 type CallOption func(*CallRequest)
 
+// WithCallHTTPHeader adds a header to the request that creates this call, on top
+// of any set with WithHTTPHeader. The X-API-Key header cannot be overridden.
+func WithCallHTTPHeader(key, value string) CallOption {
+	return func(r *CallRequest) {
+		if r.httpHeaders == nil {
+			r.httpHeaders = make(http.Header)
+		}
+		r.httpHeaders.Add(key, value)
+	}
+}
+
 // WithCallJoinTimeout overrides the join timeout for a specific call
 func WithCallJoinTimeout(timeout time.Duration) CallOption {
 	return func(r *CallRequest) {
@@ -88,6 +394,11 @@ func WithCallMaxDuration(duration time.Duration) CallOption {
 	}
 }
 
+// WithCallMaxDurationSeconds overrides the maximum duration for a specific call, in whole seconds
+func WithCallMaxDurationSeconds(seconds int) CallOption {
+	return WithCallMaxDuration(time.Duration(seconds) * time.Second)
+}
+
 // WithCallSystemPrompt overrides the system prompt for a specific call
 func WithCallSystemPrompt(prompt string) CallOption {
 	return func(r *CallRequest) {
@@ -204,6 +515,12 @@ func WithCallExotelMedium() CallOption {
 
 // WithCallSIPOutgoing configures the call to use outgoing SIP
 func WithCallSIPOutgoing(to, from, username, password string) CallOption {
+	return WithCallSIPOutgoingWithHeaders(to, from, username, password, nil)
+}
+
+// WithCallSIPOutgoingWithHeaders configures the call to use outgoing SIP and
+// adds custom headers, such as P-Asserted-Identity, to the INVITE
+func WithCallSIPOutgoingWithHeaders(to, from, username, password string, headers map[string]string) CallOption {
 	return func(r *CallRequest) {
 		r.Medium = &CallMedium{
 			SIP: &SIPMedium{
@@ -212,12 +529,27 @@ func WithCallSIPOutgoing(to, from, username, password string) CallOption {
 					From:     from,
 					Username: username,
 					Password: password,
+					Headers:  maps.Clone(headers),
 				},
 			},
 		}
 	}
 }
 
+// WithCallSIPOutgoingHeaders adds headers to the INVITE of the outgoing SIP
+// call configured by an earlier WithCallSIPOutgoing
+func WithCallSIPOutgoingHeaders(headers map[string]string) CallOption {
+	return func(r *CallRequest) {
+		if r.Medium == nil || r.Medium.SIP == nil || r.Medium.SIP.Outgoing == nil {
+			r.setOptionErr(errors.New("SIP headers require an outgoing SIP medium, set it first with WithCallSIPOutgoing"))
+			return
+		}
+		// Copy so that a medium shared with other requests is left untouched
+		r.Medium = r.Medium.clone()
+		r.Medium.SIP.Outgoing.addHeaders(headers)
+	}
+}
+
 // WithCallSIPIncoming configures the call to use incoming SIP
 func WithCallSIPIncoming() CallOption {
 	return func(r *CallRequest) {
@@ -236,6 +568,14 @@ func WithCallLanguageHint(languageHint string) CallOption {
 	}
 }
 
+// WithCallLenientLanguageHint disables the BCP-47 check on the language hint,
+// for provider-specific hints that are not standard language tags
+func WithCallLenientLanguageHint() CallOption {
+	return func(r *CallRequest) {
+		r.lenientLanguageHint = true
+	}
+}
+
 // WithCallInitialMessages sets initial messages for a specific call
 func WithCallInitialMessages(messages []Message) CallOption {
 	return func(r *CallRequest) {
@@ -243,6 +583,11 @@ func WithCallInitialMessages(messages []Message) CallOption {
 	}
 }
 
+// WithCallInitialMessagesFromTurns seeds the call with a prior conversation, see MessagesFromTurns
+func WithCallInitialMessagesFromTurns(turns []Turn) CallOption {
+	return WithCallInitialMessages(MessagesFromTurns(turns))
+}
+
 // WithCallTimeExceededMessage sets a message to be spoken when time is exceeded
 func WithCallTimeExceededMessage(message string) CallOption {
 	return func(r *CallRequest) {
@@ -257,7 +602,9 @@ func WithCallInactivityMessages(messages []TimedMessage) CallOption {
 	}
 }
 
-// WithCallRecordingEnabled sets whether recording is enabled for a specific call
+// WithCallRecordingEnabled sets whether recording is enabled for a specific
+// call. The recording's format is not configurable when the call is created;
+// use the Content-Type returned by GetCallRecording to tell what was recorded.
 func WithCallRecordingEnabled(enabled bool) CallOption {
 	return func(r *CallRequest) {
 		r.RecordingEnabled = enabled
@@ -278,7 +625,9 @@ func WithCallVadSettings(settings *VadSettings) CallOption {
 	}
 }
 
-// WithCallExperimentalSettings sets experimental settings for a specific call
+// WithCallExperimentalSettings sets experimental settings for a specific call.
+// settings is typically an ExperimentalSettings, which catches misspelled
+// keys at compile time; any other JSON-encodable value is sent as is.
 func WithCallExperimentalSettings(settings interface{}) CallOption {
 	return func(r *CallRequest) {
 		r.ExperimentalSettings = settings
@@ -354,40 +703,84 @@ func WithCallAgentID(agentID string) CallOption {
 	}
 }
 
-// Tool-related call options
+// Tool-related call options. Use SelectToolByID or SelectToolByName to also
+// override parameters or supply auth tokens.
 func WithCallToolByID(toolID string) CallOption {
+	return SelectToolByID(toolID).Option()
+}
+
+func WithCallToolByName(toolName string) CallOption {
+	return SelectToolByName(toolName).Option()
+}
+
+func WithCallTemporaryTool(tool *BaseToolDefinition) CallOption {
 	return func(r *CallRequest) {
 		if r.SelectedTools == nil {
 			r.SelectedTools = []SelectedTool{}
 		}
 		r.SelectedTools = append(r.SelectedTools, SelectedTool{
-			ToolID: toolID,
+			TemporaryTool: tool,
 		})
 	}
 }
 
-func WithCallToolByName(toolName string) CallOption {
+// WithCallSelectedTools replaces the call's tools with a copy of tools, for
+// example a list loaded from configuration
+func WithCallSelectedTools(tools []SelectedTool) CallOption {
 	return func(r *CallRequest) {
-		if r.SelectedTools == nil {
-			r.SelectedTools = []SelectedTool{}
-		}
-		r.SelectedTools = append(r.SelectedTools, SelectedTool{
-			ToolName: toolName,
-		})
+		r.SelectedTools = cloneSelectedTools(tools)
 	}
 }
 
-func WithCallTemporaryTool(tool *BaseToolDefinition) CallOption {
+// WithCallToolAuthToken sets the auth token stored under key for the selected
+// tool named toolName. The tool is matched by its ToolName, NameOverride or
+// temporary tool name and must already be selected by an earlier option.
+func WithCallToolAuthToken(toolName, key, token string) CallOption {
 	return func(r *CallRequest) {
-		if r.SelectedTools == nil {
-			r.SelectedTools = []SelectedTool{}
+		err := r.updateSelectedTool(toolName, func(tool *SelectedTool) {
+			// Copy so that maps shared with other requests are left untouched
+			tokens := maps.Clone(tool.AuthTokens)
+			if tokens == nil {
+				tokens = make(map[string]string, 1)
+			}
+			tokens[key] = token
+			tool.AuthTokens = tokens
+		})
+		if err != nil {
+			r.setOptionErr(fmt.Errorf("cannot set auth token: %w", err))
 		}
-		r.SelectedTools = append(r.SelectedTools, SelectedTool{
-			TemporaryTool: tool,
+	}
+}
+
+// WithCallToolParameterOverride fixes the value of parameter key for the
+// selected tool named toolName, matched as in WithCallToolAuthToken
+func WithCallToolParameterOverride(toolName, key string, value interface{}) CallOption {
+	return func(r *CallRequest) {
+		err := r.updateSelectedTool(toolName, func(tool *SelectedTool) {
+			overrides := maps.Clone(tool.ParameterOverrides)
+			if overrides == nil {
+				overrides = make(map[string]interface{}, 1)
+			}
+			overrides[key] = value
+			tool.ParameterOverrides = overrides
 		})
+		if err != nil {
+			r.setOptionErr(fmt.Errorf("cannot override parameter %q: %w", key, err))
+		}
 	}
 }
 
+// updateSelectedTool applies update to the first selected tool named toolName
+func (r *CallRequest) updateSelectedTool(toolName string, update func(*SelectedTool)) error {
+	for i := range r.SelectedTools {
+		if r.SelectedTools[i].hasName(toolName) {
+			update(&r.SelectedTools[i])
+			return nil
+		}
+	}
+	return fmt.Errorf("tool %q is not selected", toolName)
+}
+
 // Medium-specific call options with additional configuration
 func WithCallWebSocketMediumBuffered(inputRate, outputRate, bufferSizeMs int) CallOption {
 	return func(r *CallRequest) {
@@ -421,6 +814,45 @@ func WithCallElevenLabsVoice(voiceID string, options *ElevenLabsVoiceOptions) Ca
 	}
 }
 
+// WithCallElevenLabsPronunciation adds an ElevenLabs pronunciation dictionary
+// to the call's voice. It must come after the option that selects the voice:
+// either WithCallElevenLabsVoice, or WithCallVoice with an ElevenLabs voice ID,
+// which is converted to an ElevenLabs external voice. Any other voice makes
+// Call return an error.
+func WithCallElevenLabsPronunciation(dictionaryID, versionID string) CallOption {
+	return func(r *CallRequest) {
+		var voice ElevenLabsVoice
+		switch {
+		case r.ExternalVoice != nil && r.ExternalVoice.ElevenLabs != nil:
+			voice = *r.ExternalVoice.ElevenLabs
+		case r.ExternalVoice == nil && r.Voice != "":
+			voice = ElevenLabsVoice{VoiceID: r.Voice}
+		default:
+			r.setOptionErr(errors.New("pronunciation dictionaries require an ElevenLabs voice to be set first"))
+			return
+		}
+
+		// Copy before appending so that a voice shared with the client defaults is left untouched
+		voice.PronunciationDictionaries = append(slices.Clip(voice.PronunciationDictionaries), PronunciationDictionary{
+			DictionaryID: dictionaryID,
+			VersionID:    versionID,
+		})
+		r.Voice = ""
+		r.ExternalVoice = &ExternalVoice{ElevenLabs: &voice}
+	}
+}
+
+// WithCallElevenLabsDictionary sets the call's voice to the ElevenLabs voice
+// voiceID with a single pronunciation dictionary. Further dictionaries can be
+// added with WithCallElevenLabsPronunciation.
+func WithCallElevenLabsDictionary(voiceID, dictionaryID, versionID string) CallOption {
+	return func(r *CallRequest) {
+		voice := &ElevenLabsVoice{VoiceID: voiceID}
+		r.Voice = ""
+		r.ExternalVoice = &ExternalVoice{ElevenLabs: voice.AddPronunciationDictionary(dictionaryID, versionID)}
+	}
+}
+
 func WithCallCartesiaVoice(voiceID string, options *CartesiaVoiceOptions) CallOption {
 	return func(r *CallRequest) {
 		voice := &CartesiaVoice{
@@ -471,6 +903,70 @@ func WithCallLmntVoice(voiceID string, options *LmntVoiceOptions) CallOption {
 	}
 }
 
+func WithCallAzureTTSVoice(voiceID, region string, options *AzureTTSVoiceOptions) CallOption {
+	return func(r *CallRequest) {
+		voice := &AzureTTSVoice{
+			VoiceID: voiceID,
+			Region:  region,
+		}
+		if options != nil {
+			voice.DeploymentID = options.DeploymentID
+		}
+		r.ExternalVoice = &ExternalVoice{Azure: voice}
+	}
+}
+
+func WithCallGoogleCloudTTSVoice(voiceName, languageCode string, options *GoogleCloudTTSVoiceOptions) CallOption {
+	return func(r *CallRequest) {
+		voice := &GoogleCloudTTSVoice{
+			VoiceName:    voiceName,
+			LanguageCode: languageCode,
+		}
+		if options != nil {
+			voice.AudioProfile = options.AudioProfile
+		}
+		r.ExternalVoice = &ExternalVoice{GoogleCloud: voice}
+	}
+}
+
+func WithCallAmazonPollyVoice(voiceID string, options *AmazonPollyVoiceOptions) CallOption {
+	return func(r *CallRequest) {
+		voice := &AmazonPollyVoice{
+			VoiceID: voiceID,
+		}
+		if options != nil {
+			voice.Engine = options.Engine
+			voice.LexiconNames = options.LexiconNames
+		}
+		r.ExternalVoice = &ExternalVoice{AmazonPolly: voice}
+	}
+}
+
+func WithCallOpenAITTSVoice(voiceID string, options *OpenAITTSVoiceOptions) CallOption {
+	return func(r *CallRequest) {
+		voice := &OpenAITTSVoice{
+			VoiceID: voiceID,
+		}
+		if options != nil {
+			voice.Model = options.Model
+			voice.Speed = options.Speed
+		}
+		r.ExternalVoice = &ExternalVoice{OpenAI: voice}
+	}
+}
+
+func WithCallDeepgramTTSVoice(model string, options *DeepgramTTSVoiceOptions) CallOption {
+	return func(r *CallRequest) {
+		voice := &DeepgramTTSVoice{
+			Model: model,
+		}
+		if options != nil {
+			voice.VoiceName = options.VoiceName
+		}
+		r.ExternalVoice = &ExternalVoice{Deepgram: voice}
+	}
+}
+
 // Voice options structures for advanced configuration
 type ElevenLabsVoiceOptions struct {
 	Model                    string  `json:"model,omitempty" yaml:"model,omitempty"`
@@ -508,6 +1004,35 @@ type LmntVoiceOptions struct {
 	Conversational bool    `json:"conversational,omitempty" yaml:"conversational,omitempty"`
 }
 
+type AzureTTSVoiceOptions struct {
+	DeploymentID string `json:"deploymentId,omitempty" yaml:"deploymentId,omitempty"`
+}
+
+type GoogleCloudTTSVoiceOptions struct {
+	AudioProfile string `json:"audioProfile,omitempty" yaml:"audioProfile,omitempty"`
+}
+
+type AmazonPollyVoiceOptions struct {
+	Engine       string   `json:"engine,omitempty" yaml:"engine,omitempty"`
+	LexiconNames []string `json:"lexiconNames,omitempty" yaml:"lexiconNames,omitempty"`
+}
+
+type OpenAITTSVoiceOptions struct {
+	Model string  `json:"model,omitempty" yaml:"model,omitempty"`
+	Speed float64 `json:"speed,omitempty" yaml:"speed,omitempty"`
+}
+
+type DeepgramTTSVoiceOptions struct {
+	VoiceName string `json:"voiceName,omitempty" yaml:"voiceName,omitempty"`
+}
+
+type GenericVoiceOptions struct {
+	Headers                map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	ResponseSampleRate     int               `json:"responseSampleRate,omitempty" yaml:"responseSampleRate,omitempty"`
+	ResponseWordsPerMinute int               `json:"responseWordsPerMinute,omitempty" yaml:"responseWordsPerMinute,omitempty"`
+	ResponseMimeType       string            `json:"responseMimeType,omitempty" yaml:"responseMimeType,omitempty"`
+}
+
 // Advanced VAD configuration
 func WithCallAdvancedVadSettings(turnEndpoint, minTurn, minInterruption time.Duration, threshold float64) CallOption {
 	return func(r *CallRequest) {
@@ -537,6 +1062,38 @@ func NewAgentMessage(text string, medium OutputMediumType) Message {
 	}
 }
 
+// Turn is one utterance of a prior conversation, as used by MessagesFromTurns
+type Turn struct {
+	Role string `json:"role" yaml:"role"`
+	Text string `json:"text" yaml:"text"`
+}
+
+// turnRoles maps the conversational roles accepted in a Turn to message roles
+var turnRoles = map[string]MessageRole{
+	"user":      MessageRoleUser,
+	"agent":     MessageRoleAgent,
+	"assistant": MessageRoleAgent,
+}
+
+// MessagesFromTurns converts prior conversation turns into voice messages
+// suitable for InitialMessages. The roles "user", "agent" and "assistant" are
+// matched case-insensitively; any other role is passed through unchanged.
+func MessagesFromTurns(turns []Turn) []Message {
+	messages := make([]Message, 0, len(turns))
+	for _, turn := range turns {
+		role := turn.Role
+		if mapped, ok := turnRoles[strings.ToLower(role)]; ok {
+			role = string(mapped)
+		}
+		messages = append(messages, Message{
+			Role:   role,
+			Text:   turn.Text,
+			Medium: OutputMediumVoice,
+		})
+	}
+	return messages
+}
+
 func NewToolCallMessage(toolName, invocationID, arguments string) Message {
 	return Message{
 		Role:         string(MessageRoleToolCall),
@@ -567,6 +1124,46 @@ func NewHTTPTool(name, description, baseURL, method string) *BaseToolDefinition
 	}
 }
 
+// NewHTTPToolWithBearerAuth creates an HTTP tool that sends an
+// "Authorization: Bearer" header. The token is supplied per call under the
+// "Authorization" key of SelectedTool.AuthTokens.
+func NewHTTPToolWithBearerAuth(name, description, baseURL, method string) *BaseToolDefinition {
+	return newHTTPToolWithSecurity(name, description, baseURL, method, "Authorization", SecurityRequirement{
+		HTTPAuth: &HTTPAuthRequirement{Scheme: "Bearer"},
+	})
+}
+
+// NewHTTPToolWithAPIKeyHeader creates an HTTP tool that sends an API key in
+// the headerName header. The key is supplied per call under the headerName key
+// of SelectedTool.AuthTokens.
+func NewHTTPToolWithAPIKeyHeader(name, description, baseURL, method, headerName string) *BaseToolDefinition {
+	return newHTTPToolWithSecurity(name, description, baseURL, method, headerName, SecurityRequirement{
+		HeaderAPIKey: &HeaderAPIKeyRequirement{Name: headerName},
+	})
+}
+
+// NewHTTPToolWithQueryAPIKey creates an HTTP tool that sends an API key in the
+// paramName query parameter. The key is supplied per call under the paramName
+// key of SelectedTool.AuthTokens.
+func NewHTTPToolWithQueryAPIKey(name, description, baseURL, method, paramName string) *BaseToolDefinition {
+	return newHTTPToolWithSecurity(name, description, baseURL, method, paramName, SecurityRequirement{
+		QueryAPIKey: &QueryAPIKeyRequirement{Name: paramName},
+	})
+}
+
+// newHTTPToolWithSecurity creates an HTTP tool with a single security requirement stored under key
+func newHTTPToolWithSecurity(name, description, baseURL, method, key string, requirement SecurityRequirement) *BaseToolDefinition {
+	tool := NewHTTPTool(name, description, baseURL, method)
+	tool.Requirements = &ToolRequirements{
+		HTTPSecurityOptions: &SecurityOptions{
+			Options: []SecurityRequirements{
+				{Requirements: map[string]SecurityRequirement{key: requirement}},
+			},
+		},
+	}
+	return tool
+}
+
 func NewClientTool(name, description string) *BaseToolDefinition {
 	return &BaseToolDefinition{
 		ModelToolName: name,
@@ -609,6 +1206,66 @@ func NewAutomaticParameter(name string, location ParameterLocation, knownValue K
 	}
 }
 
+// NewQueryParameter creates a dynamic parameter sent in the query string
+func NewQueryParameter(name string, schema interface{}, required bool) DynamicParameter {
+	return NewDynamicParameter(name, ParameterLocationQuery, schema, required)
+}
+
+// NewPathParameter creates a dynamic parameter sent in the URL path
+func NewPathParameter(name string, schema interface{}, required bool) DynamicParameter {
+	return NewDynamicParameter(name, ParameterLocationPath, schema, required)
+}
+
+// NewHeaderParameter creates a dynamic parameter sent in the request headers
+func NewHeaderParameter(name string, schema interface{}, required bool) DynamicParameter {
+	return NewDynamicParameter(name, ParameterLocationHeader, schema, required)
+}
+
+// NewBodyParameter creates a dynamic parameter sent in the request body
+func NewBodyParameter(name string, schema interface{}, required bool) DynamicParameter {
+	return NewDynamicParameter(name, ParameterLocationBody, schema, required)
+}
+
+// NewStaticQueryParameter creates a static parameter sent in the query string
+func NewStaticQueryParameter(name string, value interface{}) StaticParameter {
+	return NewStaticParameter(name, ParameterLocationQuery, value)
+}
+
+// NewStaticPathParameter creates a static parameter sent in the URL path
+func NewStaticPathParameter(name string, value interface{}) StaticParameter {
+	return NewStaticParameter(name, ParameterLocationPath, value)
+}
+
+// NewStaticHeaderParameter creates a static parameter sent in the request headers
+func NewStaticHeaderParameter(name string, value interface{}) StaticParameter {
+	return NewStaticParameter(name, ParameterLocationHeader, value)
+}
+
+// NewStaticBodyParameter creates a static parameter sent in the request body
+func NewStaticBodyParameter(name string, value interface{}) StaticParameter {
+	return NewStaticParameter(name, ParameterLocationBody, value)
+}
+
+// NewAutomaticQueryParameter creates an automatic parameter sent in the query string
+func NewAutomaticQueryParameter(name string, knownValue KnownParameterValue) AutomaticParameter {
+	return NewAutomaticParameter(name, ParameterLocationQuery, knownValue)
+}
+
+// NewAutomaticPathParameter creates an automatic parameter sent in the URL path
+func NewAutomaticPathParameter(name string, knownValue KnownParameterValue) AutomaticParameter {
+	return NewAutomaticParameter(name, ParameterLocationPath, knownValue)
+}
+
+// NewAutomaticHeaderParameter creates an automatic parameter sent in the request headers
+func NewAutomaticHeaderParameter(name string, knownValue KnownParameterValue) AutomaticParameter {
+	return NewAutomaticParameter(name, ParameterLocationHeader, knownValue)
+}
+
+// NewAutomaticBodyParameter creates an automatic parameter sent in the request body
+func NewAutomaticBodyParameter(name string, knownValue KnownParameterValue) AutomaticParameter {
+	return NewAutomaticParameter(name, ParameterLocationBody, knownValue)
+}
+
 // Channel mode constants for data connections
 const (
 	ChannelModeUnspecified ChannelModeType = "CHANNEL_MODE_UNSPECIFIED"
@@ -1,5 +1,7 @@
 package ultravox
 
+import "encoding/json"
+
 // CallStage represents a stage within a call
 type CallStage struct {
 	CallID               string         `json:"callId" yaml:"callId"`
@@ -62,13 +64,24 @@ type DataConnectionCallToolDetails struct {
 
 // CallEvent represents an event that occurred during a call
 type CallEvent struct {
-	CallID        string       `json:"callId" yaml:"callId"`
-	CallStageID   string       `json:"callStageId" yaml:"callStageId"`
-	CallTimestamp string       `json:"callTimestamp" yaml:"callTimestamp"`
-	Severity      SeverityType `json:"severity" yaml:"severity"`
-	Type          string       `json:"type" yaml:"type"`
-	Text          string       `json:"text" yaml:"text"`
-	Extras        interface{}  `json:"extras,omitempty" yaml:"extras,omitempty"`
+	CallID        string          `json:"callId" yaml:"callId"`
+	CallStageID   string          `json:"callStageId" yaml:"callStageId"`
+	CallTimestamp string          `json:"callTimestamp" yaml:"callTimestamp"`
+	Severity      SeverityType    `json:"severity" yaml:"severity"`
+	Type          string          `json:"type" yaml:"type"`
+	Text          string          `json:"text" yaml:"text"`
+	Extras        json.RawMessage `json:"extras,omitempty" yaml:"extras,omitempty"`
+}
+
+// CallEventPage is a single page of a call's events returned by ListCallEvents
+type CallEventPage struct {
+	Results []CallEvent `json:"results"`
+	Next    string      `json:"next,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *CallEventPage) NextCursor() string {
+	return cursorFromURL(p.Next)
 }
 
 // SeverityType defines the severity of an event
@@ -0,0 +1,240 @@
+package ultravox_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    ultravox.CallRequest
+		wantErrors []string
+	}{
+		{
+			name: "Valid request",
+			request: ultravox.CallRequest{
+				Temperature: 0.7,
+				MaxDuration: ultravox.UltravoxDuration(5 * time.Minute),
+				Medium: &ultravox.CallMedium{
+					ServerWebSocket: &ultravox.WebSocketMedium{InputSampleRate: 8000},
+				},
+				VadSettings: ultravox.NewVadSettings(),
+			},
+		},
+		{
+			name: "Temperature out of range",
+			request: ultravox.CallRequest{
+				Temperature: 2.5,
+			},
+			wantErrors: []string{"temperature must be between 0 and 2"},
+		},
+		{
+			name: "Negative durations",
+			request: ultravox.CallRequest{
+				MaxDuration: ultravox.UltravoxDuration(-time.Second),
+				JoinTimeout: ultravox.UltravoxDuration(-time.Second),
+			},
+			wantErrors: []string{"maxDuration must not be negative", "joinTimeout must not be negative"},
+		},
+		{
+			name:    "Regional language hint",
+			request: ultravox.CallRequest{LanguageHint: "en-US"},
+		},
+		{
+			name:    "Language hint",
+			request: ultravox.CallRequest{LanguageHint: "es"},
+		},
+		{
+			name:       "Invalid language hint",
+			request:    ultravox.CallRequest{LanguageHint: "klingon!"},
+			wantErrors: []string{`languageHint "klingon!" is not a valid BCP-47 tag`},
+		},
+		{
+			name:       "Language name as hint",
+			request:    ultravox.CallRequest{LanguageHint: "english"},
+			wantErrors: []string{`languageHint "english" is not a valid BCP-47 tag`},
+		},
+		{
+			name: "Both first speaker fields",
+			request: ultravox.CallRequest{
+				FirstSpeaker:         ultravox.FirstSpeakerAgent,
+				FirstSpeakerSettings: ultravox.AgentFirstSpeaker(false, "Hi", "", 0),
+			},
+			wantErrors: []string{"firstSpeaker and firstSpeakerSettings cannot both be set"},
+		},
+		{
+			name: "Zero WebSocket input sample rate",
+			request: ultravox.CallRequest{
+				Medium: &ultravox.CallMedium{
+					ServerWebSocket: &ultravox.WebSocketMedium{},
+				},
+			},
+			wantErrors: []string{"inputSampleRate must be positive"},
+		},
+		{
+			name: "More than one transport",
+			request: ultravox.CallRequest{
+				Medium: &ultravox.CallMedium{
+					WebRTC: &ultravox.WebRTCMedium{},
+					Twilio: &ultravox.TwilioMedium{},
+				},
+			},
+			wantErrors: []string{"medium: only one transport may be set, got 2: webRtc, twilio"},
+		},
+		{
+			name: "No transport",
+			request: ultravox.CallRequest{
+				Medium: &ultravox.CallMedium{},
+			},
+			wantErrors: []string{"medium: a transport is required"},
+		},
+		{
+			name: "Non-positive inactivity durations",
+			request: ultravox.CallRequest{
+				InactivityMessages: []ultravox.TimedMessage{
+					{Duration: ultravox.UltravoxDuration(30 * time.Second), Message: "Are you there?"},
+					{Duration: 0, Message: "Still there?"},
+					{Duration: ultravox.UltravoxDuration(-time.Second), Message: "Goodbye"},
+				},
+			},
+			wantErrors: []string{
+				"inactivityMessages[1]: duration must be positive",
+				"inactivityMessages[2]: duration must be positive",
+			},
+		},
+		{
+			name: "Collects every violation",
+			request: ultravox.CallRequest{
+				Temperature: -1,
+				VadSettings: &ultravox.VadSettings{
+					TurnEndpointDelay:        ultravox.UltravoxDuration(-time.Millisecond),
+					FrameActivationThreshold: 1.5,
+				},
+			},
+			wantErrors: []string{
+				"temperature must be between 0 and 2",
+				"vadSettings: turnEndpointDelay must not be negative",
+				"frameActivationThreshold must be between 0 and 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+
+			if len(tt.wantErrors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, want := range tt.wantErrors {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestWithCallLenientLanguageHint(t *testing.T) {
+	var request ultravox.CallRequest
+	ultravox.WithCallLanguageHint("klingon!")(&request)
+	require.Error(t, request.Validate())
+
+	ultravox.WithCallLenientLanguageHint()(&request)
+	assert.NoError(t, request.Validate())
+	assert.Equal(t, "klingon!", request.LanguageHint)
+}
+
+func TestMessagesFromTurns(t *testing.T) {
+	turns := []ultravox.Turn{
+		{Role: "user", Text: "Where is my order?"},
+		{Role: "Assistant", Text: "It ships tomorrow."},
+		{Role: "agent", Text: "Anything else?"},
+		{Role: string(ultravox.MessageRoleUser), Text: "No, thanks."},
+	}
+
+	assert.Equal(t, []ultravox.Message{
+		ultravox.NewUserMessage("Where is my order?", ultravox.OutputMediumVoice),
+		ultravox.NewAgentMessage("It ships tomorrow.", ultravox.OutputMediumVoice),
+		ultravox.NewAgentMessage("Anything else?", ultravox.OutputMediumVoice),
+		ultravox.NewUserMessage("No, thanks.", ultravox.OutputMediumVoice),
+	}, ultravox.MessagesFromTurns(turns))
+
+	t.Run("Call option", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallInitialMessagesFromTurns(turns[:1])(&request)
+		assert.Equal(t, []ultravox.Message{
+			ultravox.NewUserMessage("Where is my order?", ultravox.OutputMediumVoice),
+		}, request.InitialMessages)
+	})
+}
+
+func TestCall_Timing(t *testing.T) {
+	tests := []struct {
+		name         string
+		call         ultravox.Call
+		wantActive   bool
+		wantDuration time.Duration
+		wantErr      error
+		wantErrText  string
+	}{
+		{
+			name: "Ended",
+			call: ultravox.Call{
+				Created: "2023-05-20T12:34:00Z",
+				Joined:  "2023-05-20T12:34:56Z",
+				Ended:   "2023-05-20T12:40:00.5Z",
+			},
+			wantDuration: 5*time.Minute + 4*time.Second + 500*time.Millisecond,
+		},
+		{
+			name:       "In progress",
+			call:       ultravox.Call{Created: "2023-05-20T12:34:00Z", Joined: "2023-05-20T12:34:56Z"},
+			wantActive: true,
+			wantErr:    ultravox.ErrCallNotEnded,
+		},
+		{
+			name:    "Not joined yet",
+			call:    ultravox.Call{Created: "2023-05-20T12:34:00Z"},
+			wantErr: ultravox.ErrCallNotEnded,
+		},
+		{
+			name:        "Invalid timestamp",
+			call:        ultravox.Call{Joined: "yesterday", Ended: "2023-05-20T12:40:00Z"},
+			wantErrText: `joined: invalid timestamp "yesterday"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantActive, tt.call.IsActive())
+
+			d, err := tt.call.Duration()
+			switch {
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErrText != "":
+				assert.ErrorContains(t, err, tt.wantErrText)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantDuration, d)
+			}
+		})
+	}
+
+	t.Run("Age", func(t *testing.T) {
+		call := ultravox.Call{Created: time.Now().Add(-time.Minute).Format(time.RFC3339Nano)}
+		age, err := call.Age()
+		require.NoError(t, err)
+		assert.InDelta(t, time.Minute, age, float64(5*time.Second))
+
+		_, err = (&ultravox.Call{}).Age()
+		assert.ErrorContains(t, err, "created: invalid timestamp")
+	})
+}
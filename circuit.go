@@ -0,0 +1,122 @@
+package ultravox
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+// Circuit breaker states
+const (
+	// CircuitClosed lets all requests through while counting consecutive failures
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects requests with ErrCircuitOpen until the timeout passes
+	CircuitOpen
+	// CircuitHalfOpen lets a single probe request through to test the API
+	CircuitHalfOpen
+)
+
+// String returns the state's name
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops requests to a failing API. After FailureThreshold
+// consecutive failures it opens, rejecting requests for Timeout before letting
+// a single probe through; the probe's outcome closes or reopens the circuit.
+// Transport errors and 5xx responses count as failures.
+type CircuitBreaker struct {
+	FailureThreshold int
+	Timeout          time.Duration
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+}
+
+// NewCircuitBreaker creates a closed circuit breaker
+func NewCircuitBreaker(failureThreshold int, timeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{FailureThreshold: failureThreshold, Timeout: timeout}
+}
+
+// WithCircuitBreaker fails requests fast with ErrCircuitOpen once the API has
+// failed failureThreshold times in a row, probing it again after timeout
+func WithCircuitBreaker(failureThreshold int, timeout time.Duration) Option {
+	return func(c *Config) {
+		c.CircuitBreaker = NewCircuitBreaker(failureThreshold, timeout)
+	}
+}
+
+// State returns the current state of the circuit
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// allow reports whether a request may be sent, moving an open circuit to
+// half-open once its timeout has passed. A nil breaker allows everything.
+func (b *CircuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.Timeout {
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		return nil
+	case CircuitHalfOpen:
+		// A probe is already in flight
+		return ErrCircuitOpen
+	default:
+		return nil
+	}
+}
+
+// record updates the circuit with the outcome of a request admitted by allow
+func (b *CircuitBreaker) record(ctx context.Context, resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case err != nil && ctx.Err() != nil:
+		// The caller gave up, which says nothing about the API. Release the
+		// probe slot without restarting the timeout so the next request probes.
+		if b.state == CircuitHalfOpen {
+			b.state = CircuitOpen
+		}
+	case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+		b.failures++
+		if b.state == CircuitHalfOpen || b.failures >= b.FailureThreshold {
+			b.state = CircuitOpen
+			b.openedAt = time.Now()
+		}
+	default:
+		b.state = CircuitClosed
+		b.failures = 0
+	}
+}
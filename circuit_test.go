@@ -0,0 +1,96 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	requests := 0
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123"}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithCircuitBreaker(2, 50*time.Millisecond),
+	)
+	client.WithHTTPClient(mockClient)
+	ctx := context.Background()
+
+	// Consecutive server errors open the circuit
+	for range 2 {
+		_, err := client.GetCall(ctx, "call-123")
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, ultravox.ErrCircuitOpen))
+	}
+	assert.Equal(t, ultravox.CircuitOpen, client.CircuitState())
+
+	// While open, requests fail without reaching the API
+	_, err := client.GetCall(ctx, "call-123")
+	assert.ErrorIs(t, err, ultravox.ErrCircuitOpen)
+	assert.Equal(t, 2, requests)
+
+	// A failed probe reopens the circuit for another timeout
+	time.Sleep(60 * time.Millisecond)
+	_, err = client.GetCall(ctx, "call-123")
+	assert.False(t, errors.Is(err, ultravox.ErrCircuitOpen))
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, ultravox.CircuitOpen, client.CircuitState())
+
+	_, err = client.GetCall(ctx, "call-123")
+	assert.ErrorIs(t, err, ultravox.ErrCircuitOpen)
+
+	// A successful probe closes it again
+	status = http.StatusOK
+	time.Sleep(60 * time.Millisecond)
+	_, err = client.GetCall(ctx, "call-123")
+	require.NoError(t, err)
+	assert.Equal(t, ultravox.CircuitClosed, client.CircuitState())
+	assert.Equal(t, 4, requests)
+}
+
+func TestClient_CircuitBreakerIgnoresClientErrors(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Not found."}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithCircuitBreaker(1, time.Minute),
+	)
+	client.WithHTTPClient(mockClient)
+
+	for range 3 {
+		_, err := client.GetCall(context.Background(), "missing")
+		assert.ErrorIs(t, err, ultravox.ErrNotFound)
+	}
+	assert.Equal(t, ultravox.CircuitClosed, client.CircuitState())
+}
+
+func TestCircuitState_String(t *testing.T) {
+	assert.Equal(t, "closed", ultravox.CircuitClosed.String())
+	assert.Equal(t, "open", ultravox.CircuitOpen.String())
+	assert.Equal(t, "half-open", ultravox.CircuitHalfOpen.String())
+}
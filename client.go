@@ -2,15 +2,26 @@
 package ultravox
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
 	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 )
 
+// Version is the version of this client library, reported in the default User-Agent
+const Version = "0.2.0"
+
 // Constants for default configuration values
 const (
 	DefaultAPIBaseURL       = "https://api.ultravox.ai/api"
@@ -19,7 +30,10 @@ const (
 	DefaultInputSampleRate  = 8000
 	DefaultOutputSampleRate = 8000
 	DefaultTimeout          = 15 * time.Second
+	DefaultJoinTimeout      = 30 * time.Second
+	DefaultMaxDuration      = 10 * time.Minute
 	DefaultSystemPrompt     = "You are a helpful AI assistant that provides clear and concise information."
+	DefaultUserAgent        = "ultravox-go/" + Version
 )
 
 // OutputMediumType defines the type of output medium
@@ -56,6 +70,35 @@ type Config struct {
 	APIKey      string
 	APIBaseURL  string
 	HTTPTimeout time.Duration
+	Transport   http.RoundTripper
+	UserAgent   string
+	Headers     http.Header
+	RetryPolicy *RetryPolicy
+	RateLimiter *rate.Limiter
+
+	// Interceptors wrap the built-in HTTP transport, outermost last
+	Interceptors []HTTPInterceptor
+
+	// MetricsRegisterer, when set, receives Prometheus metrics for API requests
+	MetricsRegisterer prometheus.Registerer
+
+	// CircuitBreaker, when set, fails requests fast while the API is unhealthy
+	CircuitBreaker *CircuitBreaker
+
+	// SkipValidation disables the local CallRequest checks made before each call
+	SkipValidation bool
+
+	// MaxDurationCeiling, when positive, is the largest call MaxDuration accepted
+	MaxDurationCeiling time.Duration
+
+	// BulkConcurrency caps the number of calls BulkCall creates at once; zero means no limit
+	BulkConcurrency int
+
+	// Observer, when set, is called after every Call with its outcome
+	Observer func(CallMetrics)
+
+	TracerProvider trace.TracerProvider
+	Logger         Logger
 }
 
 // Option is a function that modifies the client configuration
@@ -131,13 +174,24 @@ func WithFirstSpeakerSettings(settings *FirstSpeakerSettings) Option {
 	}
 }
 
-// WithHTTPTimeout sets the timeout for HTTP requests
+// WithHTTPTimeout sets the timeout for HTTP requests. Streaming downloads such as
+// GetCallRecording only apply it while waiting for the response headers.
 func WithHTTPTimeout(timeout time.Duration) Option {
 	return func(c *Config) {
 		c.HTTPTimeout = timeout
 	}
 }
 
+// WithTransport sends requests through transport instead of
+// http.DefaultTransport, keeping the client's timeout, interceptors and
+// redirect policy. Use it to add tracing or to record and replay requests in
+// tests; use Client.WithHTTPClient to replace the HTTP client entirely.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Config) {
+		c.Transport = transport
+	}
+}
+
 // WithJoinTimeout sets the join timeout for the client configuration
 func WithJoinTimeout(timeout time.Duration) Option {
 	return func(c *Config) {
@@ -152,6 +206,15 @@ func WithMaxDuration(duration time.Duration) Option {
 	}
 }
 
+// WithMaxDurationCeiling rejects calls whose maximum duration exceeds ceiling
+// before they are sent, typically set to the limit of your Ultravox account.
+// The check is part of call validation and is skipped by WithSkipValidation.
+func WithMaxDurationCeiling(ceiling time.Duration) Option {
+	return func(c *Config) {
+		c.MaxDurationCeiling = ceiling
+	}
+}
+
 // WithInitialOutputMedium sets the initial output medium (voice or text)
 func WithInitialOutputMedium(medium OutputMediumType) Option {
 	return func(c *Config) {
@@ -180,6 +243,137 @@ func WithRecordingEnabled(enabled bool) Option {
 	}
 }
 
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Config) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithHTTPHeader adds a header to every request. It may be given several times,
+// and repeating a key adds another value. The X-API-Key header cannot be set
+// this way; use WithAPIKey instead.
+func WithHTTPHeader(key, value string) Option {
+	return func(c *Config) {
+		if c.Headers == nil {
+			c.Headers = make(http.Header)
+		}
+		c.Headers.Add(key, value)
+	}
+}
+
+// WithRetryPolicy retries requests that fail with a retryable status using jittered
+// exponential backoff. See RetryPolicy for which statuses are retried.
+func WithRetryPolicy(maxAttempts int, initialDelay, maxDelay time.Duration) Option {
+	return func(c *Config) {
+		c.RetryPolicy = &RetryPolicy{
+			MaxAttempts:  maxAttempts,
+			InitialDelay: initialDelay,
+			MaxDelay:     maxDelay,
+		}
+	}
+}
+
+// WithRateLimit throttles outgoing API requests to rps per second, allowing bursts of up to burst requests
+func WithRateLimit(rps float64, burst int) Option {
+	return func(c *Config) {
+		c.RateLimiter = rate.NewLimiter(rate.Limit(rps), burst)
+	}
+}
+
+// WithRateLimiter throttles outgoing API requests with limiter. Passing the
+// same limiter to several clients, or the one returned by another client's
+// RateLimiter, makes them share a single request budget.
+func WithRateLimiter(limiter *rate.Limiter) Option {
+	return func(c *Config) {
+		c.RateLimiter = limiter
+	}
+}
+
+// WithSkipValidation sends call requests as-is, leaving all validation to the API
+func WithSkipValidation() Option {
+	return func(c *Config) {
+		c.SkipValidation = true
+	}
+}
+
+// Clone returns a deep copy of the configuration, so that changing the copy's
+// call defaults, headers or retry policy does not affect c. The transport,
+// rate limiter, circuit breaker, metrics registerer, tracer provider and
+// logger hold shared state and are not copied.
+func (c Config) Clone() Config {
+	clone := c
+	clone.CallRequest = c.CallRequest.clone()
+	clone.Headers = c.Headers.Clone()
+	clone.RetryPolicy = clonePtr(c.RetryPolicy)
+	clone.Interceptors = slices.Clone(c.Interceptors)
+	return clone
+}
+
+// Merge returns a copy of c with the non-zero fields of other layered on top,
+// such as per-tenant overrides on company-wide defaults. Slices are appended,
+// maps and headers are merged key by key with other taking precedence, and
+// neither c nor other is modified. A zero value in other never overrides c, so
+// a boolean that is true in c cannot be reset to false by Merge.
+func (c Config) Merge(other Config) Config {
+	merged := c.Clone()
+
+	merged.CallRequest.merge(other.CallRequest)
+	if other.APIKey != "" {
+		merged.APIKey = other.APIKey
+	}
+	if other.APIBaseURL != "" {
+		merged.APIBaseURL = other.APIBaseURL
+	}
+	if other.HTTPTimeout != 0 {
+		merged.HTTPTimeout = other.HTTPTimeout
+	}
+	if other.Transport != nil {
+		merged.Transport = other.Transport
+	}
+	if other.UserAgent != "" {
+		merged.UserAgent = other.UserAgent
+	}
+	if len(other.Headers) > 0 {
+		if merged.Headers == nil {
+			merged.Headers = make(http.Header, len(other.Headers))
+		}
+		maps.Copy(merged.Headers, other.Headers.Clone())
+	}
+	if other.RetryPolicy != nil {
+		merged.RetryPolicy = clonePtr(other.RetryPolicy)
+	}
+	if other.RateLimiter != nil {
+		merged.RateLimiter = other.RateLimiter
+	}
+	merged.Interceptors = append(merged.Interceptors, other.Interceptors...)
+	if other.MetricsRegisterer != nil {
+		merged.MetricsRegisterer = other.MetricsRegisterer
+	}
+	if other.CircuitBreaker != nil {
+		merged.CircuitBreaker = other.CircuitBreaker
+	}
+	if other.SkipValidation {
+		merged.SkipValidation = true
+	}
+	if other.BulkConcurrency != 0 {
+		merged.BulkConcurrency = other.BulkConcurrency
+	}
+	if other.MaxDurationCeiling != 0 {
+		merged.MaxDurationCeiling = other.MaxDurationCeiling
+	}
+	if other.Observer != nil {
+		merged.Observer = other.Observer
+	}
+	if other.TracerProvider != nil {
+		merged.TracerProvider = other.TracerProvider
+	}
+	if other.Logger != nil {
+		merged.Logger = other.Logger
+	}
+	return merged
+}
+
 // HTTPClient defines the interface for making HTTP requests
 // This makes testing easier by allowing mock implementations
 type HTTPClient interface {
@@ -190,6 +384,14 @@ type HTTPClient interface {
 type Client struct {
 	config Config
 	http   HTTPClient
+	tracer trace.Tracer
+	logger Logger
+
+	metrics *clientMetrics
+
+	// streamHTTP serves long-lived downloads. It has no overall timeout, so
+	// those requests are bounded only by the caller's context.
+	streamHTTP HTTPClient
 }
 
 // NewClient creates a new Ultravox client with the provided options
@@ -198,14 +400,14 @@ func NewClient(opts ...Option) *Client {
 	config := Config{
 		HTTPTimeout: DefaultTimeout,
 		APIBaseURL:  DefaultAPIBaseURL,
+		UserAgent:   DefaultUserAgent,
 		APIKey:      os.Getenv("ULTRAVOX_API_KEY"),
 		CallRequest: CallRequest{
 			Model:               DefaultModel,
 			Voice:               DefaultVoice,
-			FirstSpeaker:        FirstSpeakerAgent,
 			SystemPrompt:        DefaultSystemPrompt,
-			JoinTimeout:         UltravoxDuration(30 * time.Second),
-			MaxDuration:         UltravoxDuration(10 * time.Minute),
+			JoinTimeout:         UltravoxDuration(DefaultJoinTimeout),
+			MaxDuration:         UltravoxDuration(DefaultMaxDuration),
 			Temperature:         0.0,
 			InitialOutputMedium: OutputMediumVoice,
 			RecordingEnabled:    false,
@@ -223,39 +425,114 @@ func NewClient(opts ...Option) *Client {
 		opt(&config)
 	}
 
-	return &Client{
+	logger := config.Logger
+	if logger == nil {
+		logger = nopLogger{}
+	}
+
+	c := &Client{
 		config: config,
-		http:   &http.Client{Timeout: config.HTTPTimeout},
+		tracer: newTracer(config.TracerProvider),
+		logger: logger,
+	}
+	if config.MetricsRegisterer != nil {
+		c.metrics = newClientMetrics(config.MetricsRegisterer)
+	}
+	c.http = c.instrument(&http.Client{
+		Transport:     chainInterceptors(config.Transport, config.Interceptors),
+		Timeout:       config.HTTPTimeout,
+		CheckRedirect: checkRedirect,
+	})
+	c.streamHTTP = c.instrument(newStreamHTTPClient(config.HTTPTimeout, config.Transport, config.Interceptors))
+	return c
+}
+
+// newStreamHTTPClient builds the client used for streaming endpoints. The HTTP
+// timeout still applies while waiting for response headers, but not to reading
+// the body. A custom base transport is used as is, without the header timeout.
+func newStreamHTTPClient(headerTimeout time.Duration, base http.RoundTripper, interceptors []HTTPInterceptor) *http.Client {
+	if base == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.ResponseHeaderTimeout = headerTimeout
+		base = transport
 	}
+	return &http.Client{Transport: chainInterceptors(base, interceptors), CheckRedirect: checkRedirect}
 }
 
-// WithHTTPClient sets a custom HTTP client
+// checkRedirect follows redirects like the default policy, but drops the API key
+// when leaving the API host so it is never sent to third-party media storage
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	if req.URL.Host != via[0].URL.Host {
+		req.Header.Del("X-API-Key")
+	}
+	return nil
+}
+
+// CircuitState returns the state of the client's circuit breaker, which is
+// always CircuitClosed when none is configured
+func (c *Client) CircuitState() CircuitState {
+	if c.config.CircuitBreaker == nil {
+		return CircuitClosed
+	}
+	return c.config.CircuitBreaker.State()
+}
+
+// RateLimiter returns the limiter throttling the client's requests, or nil
+// when requests are not rate limited
+func (c *Client) RateLimiter() *rate.Limiter {
+	return c.config.RateLimiter
+}
+
+// WithHTTPClient sets a custom HTTP client, used for both unary and streaming requests
 func (c *Client) WithHTTPClient(httpClient HTTPClient) {
-	c.http = httpClient
+	c.http = c.instrument(httpClient)
+	c.streamHTTP = c.http
+}
+
+// VerifyKey checks that the configured API key is accepted by the API without
+// creating a call. An invalid or under-privileged key fails with an error
+// matching ErrUnauthorized.
+func (c *Client) VerifyKey(ctx context.Context) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.verify_key")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/accounts/me", nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
 }
 
 // Call initiates a new call with the Ultravox API
 // Optional CallOption parameters can be provided to override default configuration for this specific call
 func (c *Client) Call(ctx context.Context, opts ...CallOption) (*Call, error) {
-	// Start with default configuration from client
-	request := CallRequest{
-		SystemPrompt:         c.config.SystemPrompt,
-		Temperature:          c.config.Temperature,
-		Model:                c.config.Model,
-		Voice:                c.config.Voice,
-		ExternalVoice:        c.config.ExternalVoice,
-		LanguageHint:         c.config.LanguageHint,
-		MaxDuration:          c.config.MaxDuration,
-		JoinTimeout:          c.config.JoinTimeout,
-		FirstSpeaker:         c.config.FirstSpeaker,
-		FirstSpeakerSettings: c.config.FirstSpeakerSettings,
-		InitialOutputMedium:  c.config.InitialOutputMedium,
-		VadSettings:          c.config.VadSettings,
-		RecordingEnabled:     c.config.RecordingEnabled,
-		DataConnection:       c.config.DataConnection,
-		Medium:               c.config.Medium,
-		TemplateContext:      c.config.TemplateContext,
+	start := time.Now()
+	call, status, err := c.createCall(ctx, opts)
+
+	if c.config.Observer != nil {
+		metrics := CallMetrics{Duration: time.Since(start), StatusCode: status, Err: err}
+		if call != nil {
+			metrics.CallID = call.CallID
+		}
+		c.config.Observer(metrics)
 	}
+	return call, err
+}
+
+// createCall builds, validates and sends a call creation request, returning
+// the HTTP status code of the response alongside the result
+func (c *Client) createCall(ctx context.Context, opts []CallOption) (*Call, int, error) {
+	// Start with a deep copy of the client's defaults so that options, which
+	// may update selected tools in place, never write into the shared defaults
+	request := c.config.CallRequest.clone()
 
 	// Apply any call-specific options
 	for _, opt := range opts {
@@ -264,65 +541,54 @@ func (c *Client) Call(ctx context.Context, opts ...CallOption) (*Call, error) {
 
 	// Validate required configuration
 	if c.config.APIKey == "" {
-		return nil, fmt.Errorf("API key is required")
+		return nil, 0, fmt.Errorf("API key is required")
 	}
 
-	jsonBody, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request body: %w", err)
-	}
-
-	// Build the URL with query parameters if needed
-	url := c.buildCallURL(&request)
-	// api/agents/${AGENT_ID}/calls
-	// Add query parameters if specified
-	hasParams := false
-	if request.EnableGreetingPrompt {
-		if !hasParams {
-			url += "?enableGreetingPrompt=true"
-			hasParams = true
-		} else {
-			url += "&enableGreetingPrompt=true"
-		}
+	if request.optionErr != nil {
+		return nil, 0, request.optionErr
 	}
 
-	if request.PriorCallId != "" {
-		if !hasParams {
-			url += "?priorCallId=" + request.PriorCallId
-			hasParams = true
-		} else {
-			url += "&priorCallId=" + request.PriorCallId
-		}
+	if err := request.resolveDynamicSystemPrompt(ctx); err != nil {
+		return nil, 0, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	if !c.config.SkipValidation {
+		if err := request.Validate(); err != nil {
+			return nil, 0, fmt.Errorf("invalid call request: %w", err)
+		}
+		if ceiling := c.config.MaxDurationCeiling; ceiling > 0 && time.Duration(request.MaxDuration) > ceiling {
+			return nil, 0, fmt.Errorf("invalid call request: maxDuration %s exceeds configured ceiling %s", time.Duration(request.MaxDuration), ceiling)
+		}
+	}
+	if request.DataConnection != nil && request.DataConnection.insecure() {
+		c.logger.Warn("data connection uses unencrypted ws://", "url", request.DataConnection.WebsocketURL)
 	}
 
-	req.Header.Set("X-API-Key", c.config.APIKey)
-	req.Header.Set("Content-Type", "application/json")
+	ctx, span := c.startSpan(ctx, "ultravox.call",
+		attribute.String("call.model", request.Model),
+		attribute.String("call.voice", request.Voice),
+	)
+	defer span.End()
 
-	resp, err := c.http.Do(req)
+	req, err := c.newRequest(ctx, http.MethodPost, callPath(&request), request)
 	if err != nil {
-		return nil, fmt.Errorf("API request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API returned non-success status: %d", resp.StatusCode)
+		return nil, 0, err
 	}
+	addHeaders(req, request.httpHeaders)
 
 	var callResp Call
-	if err := json.NewDecoder(resp.Body).Decode(&callResp); err != nil {
-		return nil, fmt.Errorf("failed to decode API response: %w", err)
+	status, err := c.doStatus(req, &callResp)
+	if err != nil {
+		return nil, status, err
 	}
 
 	if callResp.JoinURL == "" {
-		return nil, fmt.Errorf("API did not return a valid join URL")
+		return nil, status, fmt.Errorf("API did not return a valid join URL")
 	}
 
-	return &callResp, nil
+	span.SetAttributes(attribute.String("call.id", callResp.CallID))
+
+	return &callResp, status, nil
 }
 
 // CallAgent initiates a call to a specific agent using the Ultravox API.
@@ -333,17 +599,291 @@ func (c *Client) CallAgent(ctx context.Context, agentID string, opts ...CallOpti
 	return c.Call(ctx, opts...)
 }
 
-// buildCallURL returns the appropriate API endpoint for creating a call.
+// GetCall retrieves the current state of an existing call by its ID
+func (c *Client) GetCall(ctx context.Context, callID string) (*Call, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return nil, fmt.Errorf("call ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.get_call", attribute.String("call.id", callID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/calls/"+url.PathEscape(callID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var call Call
+	if err := c.do(req, &call); err != nil {
+		return nil, err
+	}
+
+	return &call, nil
+}
+
+// ListCalls returns a page of the calls made by the account
+func (c *Client) ListCalls(ctx context.Context, opts ...ListOption) (*CallPage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_calls")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, buildListURL("/calls", opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page CallPage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetCallSummary retrieves the summaries of a call, which are generated
+// shortly after it ends and are empty until then. It is built from GetCall:
+// the API offers no separate summary endpoint, so key points, sentiment and
+// other analysis are not available. Duration is zero while the call is active.
+func (c *Client) GetCallSummary(ctx context.Context, callID string) (*CallSummary, error) {
+	call, err := c.GetCall(ctx, callID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := CallSummary{Short: call.ShortSummary, Full: call.Summary}
+	if d, err := call.Duration(); err == nil {
+		summary.Duration = UltravoxDuration(d)
+	}
+	return &summary, nil
+}
+
+// DeleteCall deletes a call along with its recordings and transcript
+func (c *Client) DeleteCall(ctx context.Context, callID string) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return fmt.Errorf("call ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.delete_call", attribute.String("call.id", callID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodDelete, "/calls/"+url.PathEscape(callID), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// EndCall terminates an active call before it reaches its maximum duration.
+// The API ends a call through the same endpoint used to delete it, so this
+// behaves exactly like DeleteCall.
+func (c *Client) EndCall(ctx context.Context, callID string) error {
+	return c.DeleteCall(ctx, callID)
+}
+
+// SubmitToolResult sends the result of a client-side tool invocation back to an active call
+func (c *Client) SubmitToolResult(ctx context.Context, callID, invocationID, result string) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return fmt.Errorf("call ID is required")
+	}
+	if invocationID == "" {
+		return fmt.Errorf("invocation ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.submit_tool_result",
+		attribute.String("call.id", callID),
+		attribute.String("tool.invocation_id", invocationID),
+	)
+	defer span.End()
+
+	path := fmt.Sprintf("/calls/%s/toolInvocations/%s/result", url.PathEscape(callID), url.PathEscape(invocationID))
+	req, err := c.newRequest(ctx, http.MethodPost, path, map[string]string{"result": result})
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// ListCallMessages returns a page of the messages exchanged during a call, in conversation order
+func (c *Client) ListCallMessages(ctx context.Context, callID string, opts ...ListOption) (*MessagePage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return nil, fmt.Errorf("call ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_call_messages", attribute.String("call.id", callID))
+	defer span.End()
+
+	path := buildListURL("/calls/"+url.PathEscape(callID)+"/messages", opts)
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page MessagePage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetCallMessages is an alias for ListCallMessages
+func (c *Client) GetCallMessages(ctx context.Context, callID string, opts ...ListOption) (*MessagePage, error) {
+	return c.ListCallMessages(ctx, callID, opts...)
+}
+
+// GetAllCallMessages returns the full conversation history of a call, following
+// page cursors until every message has been fetched
+func (c *Client) GetAllCallMessages(ctx context.Context, callID string, opts ...ListOption) ([]Message, error) {
+	opts = slices.Clip(opts)
+
+	var messages []Message
+	for cursor := ""; ; {
+		pageOpts := opts
+		if cursor != "" {
+			pageOpts = append(pageOpts, WithCursor(cursor))
+		}
+
+		page, err := c.ListCallMessages(ctx, callID, pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, page.Results...)
+		if cursor = page.NextCursor(); cursor == "" {
+			return messages, nil
+		}
+	}
+}
+
+// ListCallEvents returns a page of the diagnostic events recorded during a
+// call, such as tool failures or the reason a call ended. Use
+// WithSeverityFilter to drop low-severity events on the server side.
+func (c *Client) ListCallEvents(ctx context.Context, callID string, opts ...ListOption) (*CallEventPage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return nil, fmt.Errorf("call ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_call_events", attribute.String("call.id", callID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, buildListURL("/calls/"+url.PathEscape(callID)+"/events", opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page CallEventPage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetAllCallEvents returns every event recorded during a call, following page
+// cursors until the last page has been fetched
+func (c *Client) GetAllCallEvents(ctx context.Context, callID string, opts ...ListOption) ([]CallEvent, error) {
+	opts = slices.Clip(opts)
+
+	var events []CallEvent
+	for cursor := ""; ; {
+		pageOpts := opts
+		if cursor != "" {
+			pageOpts = append(pageOpts, WithCursor(cursor))
+		}
+
+		page, err := c.ListCallEvents(ctx, callID, pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, page.Results...)
+		if cursor = page.NextCursor(); cursor == "" {
+			return events, nil
+		}
+	}
+}
+
+// GetCallRecording streams the audio recording of a call, returning the body
+// and its Content-Type. The caller must close the body. Calls made without
+// recording enabled fail with ErrRecordingUnavailable. The client's HTTP
+// timeout only bounds the wait for the response; use ctx to limit the download.
+func (c *Client) GetCallRecording(ctx context.Context, callID string) (io.ReadCloser, string, error) {
+	if c.config.APIKey == "" {
+		return nil, "", fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return nil, "", fmt.Errorf("call ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.get_call_recording", attribute.String("call.id", callID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, "/calls/"+url.PathEscape(callID)+"/recording", nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The API redirects to a signed media URL, which the HTTP client follows
+	resp, err := c.sendStream(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("API request failed: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		apiErr := newAPIError(resp)
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusBadRequest {
+			return nil, "", fmt.Errorf("%w: %w", ErrRecordingUnavailable, apiErr)
+		}
+		return nil, "", apiErr
+	}
+
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// callPath returns the endpoint for creating a call, relative to the API base URL.
 // If the request includes an AgentID, it targets the agent-scoped endpoint:
 //
-//	/api/agents/{agentId}/calls
+//	/agents/{agentId}/calls
 //
 // Otherwise, it uses the default endpoint:
 //
-//	/api/calls
-func (c *Client) buildCallURL(req *CallRequest) string {
+//	/calls
+func callPath(req *CallRequest) string {
+	path := "/calls"
 	if req.AgentID != "" {
-		return fmt.Sprintf("%s/agents/%s/calls", c.config.APIBaseURL, req.AgentID)
+		path = "/agents/" + url.PathEscape(req.AgentID) + "/calls"
+	}
+
+	q := url.Values{}
+	if req.EnableGreetingPrompt {
+		q.Set("enableGreetingPrompt", "true")
+	}
+	if req.PriorCallId != "" {
+		q.Set("priorCallId", req.PriorCallId)
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
 	}
-	return fmt.Sprintf("%s/calls", c.config.APIBaseURL)
+	return path
 }
@@ -4,8 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 
@@ -267,16 +275,1187 @@ func TestClient_Call(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Nil(t, call)
+
+				// Non-success statuses must surface as a typed APIError
+				var apiErr *ultravox.APIError
+				if tt.mockStatusCode >= 300 {
+					require.True(t, errors.As(err, &apiErr))
+					assert.Equal(t, tt.mockStatusCode, apiErr.StatusCode)
+					assert.Equal(t, "Something went wrong", apiErr.Message)
+				} else {
+					assert.False(t, errors.As(err, &apiErr))
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, call)
+				assert.Equal(t, "call-123", call.CallID)
+				assert.Equal(t, "wss://example.com/join/call-123", call.JoinURL)
+			}
+		})
+	}
+}
+
+func TestClient_CallRetry(t *testing.T) {
+	successResponse := `{
+		"callId": "call-123",
+		"joinUrl": "wss://example.com/join/call-123"
+	}`
+
+	tests := []struct {
+		name         string
+		statusCodes  []int
+		maxAttempts  int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{
+			name:         "Retries until success",
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxAttempts:  5,
+			wantAttempts: 3,
+			wantErr:      false,
+		},
+		{
+			name:         "Gives up after max attempts",
+			statusCodes:  []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxAttempts:  3,
+			wantAttempts: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "Does not retry server errors",
+			statusCodes:  []int{http.StatusInternalServerError, http.StatusOK},
+			maxAttempts:  3,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Does not retry client errors",
+			statusCodes:  []int{http.StatusBadRequest, http.StatusOK},
+			maxAttempts:  3,
+			wantAttempts: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					// Every attempt must carry the full request body
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.NotEmpty(t, body)
+
+					status := tt.statusCodes[attempts]
+					attempts++
+					return &http.Response{
+						StatusCode: status,
+						Body:       io.NopCloser(bytes.NewBufferString(successResponse)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(
+				ultravox.WithAPIKey("test-api-key"),
+				ultravox.WithRetryPolicy(tt.maxAttempts, time.Millisecond, 5*time.Millisecond),
+			)
+			client.WithHTTPClient(mockClient)
+
+			call, err := client.Call(context.Background())
+
+			assert.Equal(t, tt.wantAttempts, attempts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, call)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "call-123", call.CallID)
+			}
+		})
+	}
+
+	t.Run("Stops when context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		attempts := 0
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				attempts++
+				cancel()
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(bytes.NewBufferString("{}")),
+				}, nil
+			},
+		}
+
+		client := ultravox.NewClient(
+			ultravox.WithAPIKey("test-api-key"),
+			ultravox.WithRetryPolicy(5, time.Hour, time.Hour),
+		)
+		client.WithHTTPClient(mockClient)
+
+		call, err := client.Call(ctx)
+
+		assert.Error(t, err)
+		assert.Nil(t, call)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("Server errors", func(t *testing.T) {
+		for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout} {
+			attempts := map[string]int{}
+			client := ultravox.NewClient(
+				ultravox.WithAPIKey("test-api-key"),
+				ultravox.WithRetryPolicy(3, time.Millisecond, time.Millisecond),
+			)
+			client.WithHTTPClient(&MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					attempts[req.Method]++
+					return &http.Response{
+						StatusCode: status,
+						Body:       io.NopCloser(bytes.NewBufferString("{}")),
+					}, nil
+				},
+			})
+
+			_, err := client.Call(context.Background())
+			assert.Error(t, err)
+			_, err = client.GetCall(context.Background(), "call-123")
+			assert.Error(t, err)
+
+			assert.Equal(t, 1, attempts[http.MethodPost], "POST should not be retried on %d", status)
+			assert.Equal(t, 3, attempts[http.MethodGet], "GET should be retried on %d", status)
+		}
+	})
+
+	t.Run("Transport errors", func(t *testing.T) {
+		timeout := &url.Error{Op: "Post", URL: "https://api.ultravox.ai/api/calls", Err: context.DeadlineExceeded}
+		refused := &url.Error{Op: "Post", URL: "https://api.ultravox.ai/api/calls", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+
+		tests := []struct {
+			name         string
+			do           func(client *ultravox.Client) error
+			err          error
+			wantAttempts int
+		}{
+			{
+				name: "POST is not retried after a timeout",
+				do: func(client *ultravox.Client) error {
+					_, err := client.Call(context.Background())
+					return err
+				},
+				err:          timeout,
+				wantAttempts: 1,
+			},
+			{
+				name: "POST is retried when the connection fails",
+				do: func(client *ultravox.Client) error {
+					_, err := client.Call(context.Background())
+					return err
+				},
+				err:          refused,
+				wantAttempts: 3,
+			},
+			{
+				name: "GET is retried after a timeout",
+				do: func(client *ultravox.Client) error {
+					_, err := client.GetCall(context.Background(), "call-123")
+					return err
+				},
+				err:          timeout,
+				wantAttempts: 3,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				attempts := 0
+				client := ultravox.NewClient(
+					ultravox.WithAPIKey("test-api-key"),
+					ultravox.WithRetryPolicy(3, time.Millisecond, time.Millisecond),
+				)
+				client.WithHTTPClient(&MockHTTPClient{
+					DoFunc: func(req *http.Request) (*http.Response, error) {
+						attempts++
+						return nil, tt.err
+					},
+				})
+
+				assert.Error(t, tt.do(client))
+				assert.Equal(t, tt.wantAttempts, attempts)
+			})
+		}
+	})
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	}
+
+	// One request is allowed immediately, the next only after an hour
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithRateLimit(1.0/3600, 1),
+	)
+	client.WithHTTPClient(mockClient)
+
+	_, err := client.Call(context.Background())
+	require.NoError(t, err)
+
+	t.Run("Returns deadline error while throttled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := client.Call(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Returns cancellation error while throttled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.GetCall(ctx, "call-123")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClient_SharedRateLimiter(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	}
+
+	// The first client's single token is also the second client's
+	first := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithRateLimit(1.0/3600, 1))
+	first.WithHTTPClient(mockClient)
+	second := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithRateLimiter(first.RateLimiter()))
+	second.WithHTTPClient(mockClient)
+	assert.Same(t, first.RateLimiter(), second.RateLimiter())
+
+	_, err := first.Call(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = second.Call(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Nil(t, ultravox.NewClient().RateLimiter())
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ultravox.Option
+		want string
+	}{
+		{
+			name: "Default",
+			want: "ultravox-go/" + ultravox.Version,
+		},
+		{
+			name: "Custom",
+			opts: []ultravox.Option{ultravox.WithUserAgent("campaign-dialer/2.1")},
+			want: "campaign-dialer/2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var userAgents []string
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					userAgents = append(userAgents, req.Header.Get("User-Agent"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(append([]ultravox.Option{ultravox.WithAPIKey("test-api-key")}, tt.opts...)...)
+			client.WithHTTPClient(mockClient)
+
+			_, err := client.Call(context.Background())
+			require.NoError(t, err)
+			_, err = client.GetCall(context.Background(), "call-123")
+			require.NoError(t, err)
+			_, err = client.GetAgent(context.Background(), "agent-123")
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{tt.want, tt.want, tt.want}, userAgents)
+		})
+	}
+}
+
+// countingTransport counts the requests it forwards to http.DefaultTransport
+type countingTransport struct {
+	requests atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests.Add(1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClient_WithTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/calls/slow" {
+			select {
+			case <-time.After(time.Second):
+			case <-r.Context().Done():
+			}
+		}
+		w.Write([]byte(`{"callId": "call-123"}`))
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithAPIBaseURL(server.URL),
+		ultravox.WithTransport(transport),
+		ultravox.WithHTTPTimeout(50*time.Millisecond),
+	)
+
+	_, err := client.GetCall(context.Background(), "call-123")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, transport.requests.Load())
+
+	_, err = client.GetCall(context.Background(), "slow")
+	assert.Error(t, err, "the HTTP timeout still applies")
+	assert.EqualValues(t, 2, transport.requests.Load())
+}
+
+func TestClient_HTTPHeaders(t *testing.T) {
+	var headers []http.Header
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			headers = append(headers, req.Header.Clone())
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithHTTPHeader("X-Tenant-ID", "tenant-42"),
+		ultravox.WithHTTPHeader("Traceparent", "00-trace-span-01"),
+		ultravox.WithHTTPHeader("X-API-Key", "clobbered"),
+	)
+	client.WithHTTPClient(mockClient)
+
+	_, err := client.Call(context.Background(),
+		ultravox.WithCallHTTPHeader("X-Campaign", "spring"),
+		ultravox.WithCallHTTPHeader("x-api-key", "clobbered"),
+	)
+	require.NoError(t, err)
+	_, err = client.GetCall(context.Background(), "call-123")
+	require.NoError(t, err)
+
+	require.Len(t, headers, 2)
+	for _, h := range headers {
+		assert.Equal(t, "tenant-42", h.Get("X-Tenant-ID"))
+		assert.Equal(t, "00-trace-span-01", h.Get("Traceparent"))
+		assert.Equal(t, []string{"test-api-key"}, h.Values("X-API-Key"))
+	}
+	assert.Equal(t, "spring", headers[0].Get("X-Campaign"))
+	assert.Empty(t, headers[1].Get("X-Campaign"))
+}
+
+func TestClient_VerifyKey(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockStatusCode   int
+		wantErr          bool
+		wantUnauthorized bool
+	}{
+		{
+			name:           "Valid key",
+			mockStatusCode: http.StatusOK,
+		},
+		{
+			name:             "Invalid key",
+			mockStatusCode:   http.StatusUnauthorized,
+			wantErr:          true,
+			wantUnauthorized: true,
+		},
+		{
+			name:             "Forbidden",
+			mockStatusCode:   http.StatusForbidden,
+			wantErr:          true,
+			wantUnauthorized: true,
+		},
+		{
+			name:           "Server error",
+			mockStatusCode: http.StatusInternalServerError,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "/api/accounts/me", req.URL.Path)
+					assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"name": "Acme"}`)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			err := client.VerifyKey(context.Background())
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.wantUnauthorized, errors.Is(err, ultravox.ErrUnauthorized))
+		})
+	}
+}
+
+func TestClient_CallValidation(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("invalid requests must not reach the API")
+			return nil, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	call, err := client.Call(context.Background(),
+		ultravox.WithCallTemperature(3),
+		ultravox.WithCallMaxDuration(-time.Minute),
+	)
+
+	assert.Nil(t, call)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid call request")
+	assert.Contains(t, err.Error(), "temperature")
+	assert.Contains(t, err.Error(), "maxDuration")
+
+	t.Run("Skip validation", func(t *testing.T) {
+		var sent bool
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithSkipValidation())
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				sent = true
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "temperature: out of range"}`)),
+				}, nil
+			},
+		})
+
+		_, err := client.Call(context.Background(), ultravox.WithCallTemperature(3))
+		assert.True(t, sent)
+
+		var apiErr *ultravox.APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	})
+
+	t.Run("Max duration ceiling", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithMaxDurationCeiling(time.Hour))
+		client.WithHTTPClient(mockClient)
+
+		_, err := client.Call(context.Background(), ultravox.WithCallMaxDurationSeconds(7200))
+		assert.ErrorContains(t, err, "maxDuration 2h0m0s exceeds configured ceiling 1h0m0s")
+
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+				}, nil
+			},
+		})
+		_, err = client.Call(context.Background())
+		assert.NoError(t, err, "the default max duration is within the ceiling")
+	})
+}
+
+func TestClient_GetCall(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        bool
+	}{
+		{
+			name: "Successful retrieval",
+			mockResponse: `{
+				"callId": "call-123",
+				"joinUrl": "wss://example.com/join/call-123",
+				"created": "2023-05-20T12:34:56Z",
+				"ended": "2023-05-20T12:40:00Z",
+				"endReason": "hangup",
+				"maxDuration": "3600s",
+				"joinTimeout": "300s",
+				"recordingEnabled": true,
+				"shortSummary": "User asked about an order."
+			}`,
+			mockStatusCode: http.StatusOK,
+			wantErr:        false,
+		},
+		{
+			name:           "Call not found",
+			mockResponse:   `{"detail": "Not found."}`,
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        true,
+		},
+		{
+			name:           "Invalid JSON response",
+			mockResponse:   `{invalid json}`,
+			mockStatusCode: http.StatusOK,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123", req.URL.String())
+					assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.mockResponse)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			call, err := client.GetCall(context.Background(), "call-123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, call)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "call-123", call.CallID)
+				assert.Equal(t, "hangup", call.EndReason)
+				assert.Equal(t, "User asked about an order.", call.ShortSummary)
+				assert.True(t, call.RecordingEnabled)
+				assert.Equal(t, ultravox.UltravoxDuration(time.Hour), call.MaxDuration)
+			}
+		})
+	}
+}
+
+func TestClient_GetCallSummary(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantErr        error
+	}{
+		{
+			name: "Successful retrieval",
+			mockResponse: `{
+				"callId": "call-123",
+				"joined": "2024-01-02T03:00:00Z",
+				"ended": "2024-01-02T03:03:05.5Z",
+				"shortSummary": "User asked about an order.",
+				"summary": "The user asked about the status of order 42 and was told it ships tomorrow."
+			}`,
+			mockStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Call not found",
+			mockResponse:   `{"detail": "Not found."}`,
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        ultravox.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123", req.URL.String())
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.mockResponse)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			summary, err := client.GetCallSummary(context.Background(), "call-123")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, summary)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "User asked about an order.", summary.Short)
+			assert.Contains(t, summary.Full, "order 42")
+			assert.Equal(t, ultravox.UltravoxDuration(185500*time.Millisecond), summary.Duration)
+		})
+	}
+
+	t.Run("Active call", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joined": "2024-01-02T03:00:00Z"}`)),
+				}, nil
+			},
+		})
+
+		summary, err := client.GetCallSummary(context.Background(), "call-123")
+		require.NoError(t, err)
+		assert.Equal(t, &ultravox.CallSummary{}, summary)
+	})
+
+	t.Run("Missing call ID", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		_, err := client.GetCallSummary(context.Background(), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_DeleteCall(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		wantErr        bool
+		wantNotFound   bool
+	}{
+		{
+			name:           "Deleted with no content",
+			mockStatusCode: http.StatusNoContent,
+			wantErr:        false,
+		},
+		{
+			name:           "Deleted with OK",
+			mockStatusCode: http.StatusOK,
+			wantErr:        false,
+		},
+		{
+			name:           "Call not found",
+			mockStatusCode: http.StatusNotFound,
+			wantErr:        true,
+			wantNotFound:   true,
+		},
+		{
+			name:           "Server error",
+			mockStatusCode: http.StatusInternalServerError,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodDelete, req.Method)
+					assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123", req.URL.String())
+					assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			err := client.DeleteCall(context.Background(), "call-123")
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.wantNotFound, errors.Is(err, ultravox.ErrNotFound))
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, call)
-				assert.Equal(t, "call-123", call.CallID)
-				assert.Equal(t, "wss://example.com/join/call-123", call.JoinURL)
 			}
 		})
 	}
 }
 
+func TestClient_ListCallMessages(t *testing.T) {
+	mockResponse := `{
+		"results": [
+			{"role": "MESSAGE_ROLE_AGENT", "text": "How can I help?", "medium": "MESSAGE_MEDIUM_VOICE", "timespan": {"start": "0s", "end": "1.5s"}},
+			{"role": "MESSAGE_ROLE_USER", "text": "What's the weather?", "medium": "MESSAGE_MEDIUM_VOICE"},
+			{"role": "MESSAGE_ROLE_TOOL_CALL", "toolName": "weather", "invocationId": "inv-1", "text": "{\"city\":\"Kampala\"}"},
+			{"role": "MESSAGE_ROLE_TOOL_RESULT", "toolName": "weather", "invocationId": "inv-1", "text": "Sunny"}
+		],
+		"next": "https://api.ultravox.ai/api/calls/call-123/messages?cursor=page2",
+		"total": 6
+	}`
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/calls/call-123/messages", req.URL.Path)
+			assert.Equal(t, "4", req.URL.Query().Get("pageSize"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(mockResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	page, err := client.ListCallMessages(context.Background(), "call-123", ultravox.WithPageSize(4))
+	require.NoError(t, err)
+	require.Len(t, page.Results, 4)
+
+	assert.Equal(t, string(ultravox.MessageRoleAgent), page.Results[0].Role)
+	require.NotNil(t, page.Results[0].Timespan)
+	assert.Equal(t, ultravox.UltravoxDuration(1500*time.Millisecond), page.Results[0].Timespan.End)
+
+	for _, msg := range page.Results[2:] {
+		assert.Equal(t, "weather", msg.ToolName)
+		assert.Equal(t, "inv-1", msg.InvocationID)
+	}
+	assert.Equal(t, string(ultravox.MessageRoleToolResult), page.Results[3].Role)
+
+	assert.Equal(t, 6, page.Total)
+	assert.Equal(t, "page2", page.NextCursor())
+}
+
+func TestClient_GetAllCallMessages(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"results": [{"role": "MESSAGE_ROLE_AGENT", "text": "Hello!"}],
+			"next": "https://api.ultravox.ai/api/calls/call-123/messages?cursor=page2"
+		}`,
+		"page2": `{
+			"results": [{"role": "MESSAGE_ROLE_USER", "text": "Hi"}],
+			"next": "https://api.ultravox.ai/api/calls/call-123/messages?cursor=page3"
+		}`,
+		"page3": `{
+			"results": [{"role": "MESSAGE_ROLE_AGENT", "text": "Goodbye!"}]
+		}`,
+	}
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/api/calls/call-123/messages", req.URL.Path)
+			assert.Equal(t, "100", req.URL.Query().Get("pageSize"))
+
+			body, ok := pages[req.URL.Query().Get("cursor")]
+			require.True(t, ok, "unexpected cursor %q", req.URL.Query().Get("cursor"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	messages, err := client.GetAllCallMessages(context.Background(), "call-123", ultravox.WithPageSize(100))
+	require.NoError(t, err)
+
+	var texts []string
+	for _, msg := range messages {
+		texts = append(texts, msg.Text)
+	}
+	assert.Equal(t, []string{"Hello!", "Hi", "Goodbye!"}, texts)
+
+	page, err := client.GetCallMessages(context.Background(), "call-123", ultravox.WithPageSize(100))
+	require.NoError(t, err)
+	assert.Len(t, page.Results, 1)
+	assert.Equal(t, "page2", page.NextCursor())
+}
+
+func TestClient_ListCallEvents(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"results": [
+				{"callId": "call-123", "callStageId": "stage-1", "callTimestamp": "1.2s", "severity": "warning",
+				 "type": "tool_error", "text": "Tool weather timed out", "extras": {"toolName": "weather", "attempts": 2}}
+			],
+			"next": "https://api.ultravox.ai/api/calls/call-123/events?cursor=page2&minimum_severity=warning"
+		}`,
+		"page2": `{
+			"results": [
+				{"callId": "call-123", "callStageId": "stage-1", "callTimestamp": "30s", "severity": "error",
+				 "type": "call_ended", "text": "Call ended unexpectedly"}
+			]
+		}`,
+	}
+
+	var requests int
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/calls/call-123/events", req.URL.Path)
+			assert.Equal(t, "warning", req.URL.Query().Get("minimum_severity"))
+
+			body, ok := pages[req.URL.Query().Get("cursor")]
+			require.True(t, ok, "unexpected cursor %q", req.URL.Query().Get("cursor"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	page, err := client.ListCallEvents(context.Background(), "call-123", ultravox.WithSeverityFilter(ultravox.SeverityWarning))
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "page2", page.NextCursor())
+	assert.Equal(t, ultravox.SeverityWarning, page.Results[0].Severity)
+	assert.Equal(t, "tool_error", page.Results[0].Type)
+	assert.JSONEq(t, `{"toolName": "weather", "attempts": 2}`, string(page.Results[0].Extras))
+
+	t.Run("All pages", func(t *testing.T) {
+		requests = 0
+
+		events, err := client.GetAllCallEvents(context.Background(), "call-123", ultravox.WithSeverityFilter(ultravox.SeverityWarning))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, 2, requests)
+
+		assert.Equal(t, "tool_error", events[0].Type)
+		assert.Equal(t, ultravox.SeverityError, events[1].Severity)
+		assert.Nil(t, events[1].Extras)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Not found."}`)),
+				}, nil
+			},
+		})
+
+		_, err := client.ListCallEvents(context.Background(), "missing")
+		assert.ErrorIs(t, err, ultravox.ErrNotFound)
+	})
+}
+
+func TestClient_GetCallRecording(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockStatusCode  int
+		wantUnavailable bool
+		wantErr         bool
+	}{
+		{
+			name:           "Recording available",
+			mockStatusCode: http.StatusOK,
+		},
+		{
+			name:            "Recording not enabled",
+			mockStatusCode:  http.StatusNotFound,
+			wantErr:         true,
+			wantUnavailable: true,
+		},
+		{
+			name:            "Recording not ready",
+			mockStatusCode:  http.StatusBadRequest,
+			wantErr:         true,
+			wantUnavailable: true,
+		},
+		{
+			name:           "Server error",
+			mockStatusCode: http.StatusInternalServerError,
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123/recording", req.URL.String())
+
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Header:     http.Header{"Content-Type": []string{"audio/wav"}},
+						Body:       io.NopCloser(bytes.NewBufferString("RIFF....WAVE")),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			body, contentType, err := client.GetCallRecording(context.Background(), "call-123")
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, body)
+				assert.Equal(t, tt.wantUnavailable, errors.Is(err, ultravox.ErrRecordingUnavailable))
+				assert.Equal(t, tt.wantUnavailable, errors.Is(err, ultravox.ErrRecordingNotFound))
+				return
+			}
+
+			require.NoError(t, err)
+			defer body.Close()
+
+			data, err := io.ReadAll(body)
+			require.NoError(t, err)
+			assert.Equal(t, "RIFF....WAVE", string(data))
+			assert.Equal(t, "audio/wav", contentType)
+		})
+	}
+
+	t.Run("Follows redirect without leaking the API key", func(t *testing.T) {
+		media := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Empty(t, r.Header.Get("X-API-Key"))
+			w.Header().Set("Content-Type", "audio/wav")
+			w.Write([]byte("audio"))
+		}))
+		defer media.Close()
+
+		api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "test-api-key", r.Header.Get("X-API-Key"))
+			http.Redirect(w, r, media.URL+"/signed/recording.wav", http.StatusFound)
+		}))
+		defer api.Close()
+
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithAPIBaseURL(api.URL))
+
+		body, contentType, err := client.GetCallRecording(context.Background(), "call-123")
+		require.NoError(t, err)
+		defer body.Close()
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "audio", string(data))
+		assert.Equal(t, "audio/wav", contentType)
+	})
+
+	t.Run("Download outlasts the HTTP timeout", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "audio/wav")
+			w.WriteHeader(http.StatusOK)
+			for range 5 {
+				w.Write([]byte("chunk"))
+				w.(http.Flusher).Flush()
+				time.Sleep(30 * time.Millisecond)
+			}
+		}))
+		defer server.Close()
+
+		client := ultravox.NewClient(
+			ultravox.WithAPIKey("test-api-key"),
+			ultravox.WithAPIBaseURL(server.URL),
+			ultravox.WithHTTPTimeout(50*time.Millisecond),
+		)
+
+		body, _, err := client.GetCallRecording(context.Background(), "call-123")
+		require.NoError(t, err)
+		defer body.Close()
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("chunk", 5), string(data))
+	})
+}
+
+func TestClient_EndCall(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			calls := 0
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					calls++
+					assert.Equal(t, http.MethodDelete, req.Method)
+					assert.Equal(t, "/api/calls/call-123", req.URL.Path)
+
+					return &http.Response{
+						StatusCode: status,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			assert.NoError(t, client.EndCall(context.Background(), "call-123"))
+			assert.Equal(t, 1, calls)
+		})
+	}
+
+	t.Run("Bad Request", func(t *testing.T) {
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Call already ended."}`)),
+				}, nil
+			},
+		}
+
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(mockClient)
+
+		err := client.EndCall(context.Background(), "call-123")
+
+		var apiErr *ultravox.APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	})
+}
+
+func TestClient_SubmitToolResult(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					assert.Equal(t, http.MethodPost, req.Method)
+					assert.Equal(t, "/api/calls/call-123/toolInvocations/inv-1/result", req.URL.Path)
+					assert.Equal(t, "test-api-key", req.Header.Get("X-API-Key"))
+					assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+					body, err := io.ReadAll(req.Body)
+					require.NoError(t, err)
+					assert.JSONEq(t, `{"result": "{\"temperature\": 27}"}`, string(body))
+
+					return &http.Response{
+						StatusCode: status,
+						Body:       io.NopCloser(bytes.NewBufferString("")),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			err := client.SubmitToolResult(context.Background(), "call-123", "inv-1", `{"temperature": 27}`)
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("Unknown invocation", func(t *testing.T) {
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Not found."}`)),
+				}, nil
+			},
+		}
+
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(mockClient)
+
+		err := client.SubmitToolResult(context.Background(), "call-123", "inv-404", "done")
+
+		var apiErr *ultravox.APIError
+		require.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	})
+
+	t.Run("Missing invocation ID", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		assert.Error(t, client.SubmitToolResult(context.Background(), "call-123", "", "done"))
+	})
+}
+
+func TestClient_APIError(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		wantMessage    string
+	}{
+		{
+			name:           "Detail field",
+			mockResponse:   `{"detail": "Invalid voice."}`,
+			mockStatusCode: http.StatusBadRequest,
+			wantMessage:    "Invalid voice.",
+		},
+		{
+			name:           "Error field",
+			mockResponse:   `{"error": "Rate limit exceeded"}`,
+			mockStatusCode: http.StatusTooManyRequests,
+			wantMessage:    "Rate limit exceeded",
+		},
+		{
+			name:           "Non-JSON body",
+			mockResponse:   `Bad Gateway`,
+			mockStatusCode: http.StatusBadGateway,
+			wantMessage:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.mockResponse)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(mockClient)
+
+			_, err := client.Call(context.Background())
+			require.Error(t, err)
+
+			var apiErr *ultravox.APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.mockStatusCode, apiErr.StatusCode)
+			assert.Equal(t, tt.wantMessage, apiErr.Message)
+			assert.Equal(t, []byte(tt.mockResponse), apiErr.Body)
+			assert.Contains(t, err.Error(), fmt.Sprint(tt.mockStatusCode))
+		})
+	}
+}
+
 func TestCallWithPriorCallIdAndGreetingPrompt(t *testing.T) {
 	mockClient := &MockHTTPClient{
 		DoFunc: func(req *http.Request) (*http.Response, error) {
@@ -311,6 +1490,27 @@ func TestCallWithPriorCallIdAndGreetingPrompt(t *testing.T) {
 	assert.NotNil(t, call)
 }
 
+func TestCall_QueryParamsAreEscaped(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "/api/agents/team a/calls", req.URL.Path)
+			assert.Equal(t, "prior&call=1", req.URL.Query().Get("priorCallId"))
+			assert.Empty(t, req.URL.Query().Get("call"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join/call-123"}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	_, err := client.CallAgent(context.Background(), "team a", ultravox.WithCallPriorCallId("prior&call=1"))
+	assert.NoError(t, err)
+}
+
 func TestCall_WithVadSettings(t *testing.T) {
 	mockClient := &MockHTTPClient{
 		DoFunc: func(req *http.Request) (*http.Response, error) {
@@ -561,6 +1761,142 @@ func TestClientOptions(t *testing.T) {
 		opt(config)
 		assert.Equal(t, ultravox.OutputMediumText, config.InitialOutputMedium)
 	})
+
+	t.Run("WithRetryPolicy", func(t *testing.T) {
+		opt := ultravox.WithRetryPolicy(3, 100*time.Millisecond, 2*time.Second)
+		opt(config)
+		assert.Equal(t, &ultravox.RetryPolicy{
+			MaxAttempts:  3,
+			InitialDelay: 100 * time.Millisecond,
+			MaxDelay:     2 * time.Second,
+		}, config.RetryPolicy)
+	})
+}
+
+// fullConfig returns a Config with every pointer, slice and map field set
+func fullConfig() ultravox.Config {
+	return ultravox.Config{
+		APIKey:             "base-key",
+		APIBaseURL:         "https://base.example.com",
+		HTTPTimeout:        10 * time.Second,
+		Headers:            http.Header{"X-Tenant": {"base"}},
+		RetryPolicy:        &ultravox.RetryPolicy{MaxAttempts: 3, InitialDelay: time.Second, MaxDelay: 5 * time.Second},
+		MaxDurationCeiling: 2 * time.Hour,
+		CallRequest: ultravox.CallRequest{
+			SystemPrompt:    "base prompt",
+			Voice:           "Mark",
+			ExternalVoice:   &ultravox.ExternalVoice{ElevenLabs: &ultravox.ElevenLabsVoice{VoiceID: "v1", PronunciationDictionaries: []ultravox.PronunciationDictionary{{DictionaryID: "d1"}}}},
+			InitialMessages: []ultravox.Message{ultravox.NewUserMessage("hi", ultravox.OutputMediumVoice)},
+			InactivityMessages: []ultravox.TimedMessage{
+				ultravox.NewTimedMessage(30*time.Second, "Are you there?", ultravox.EndBehaviorDefault),
+			},
+			SelectedTools: []ultravox.SelectedTool{{
+				ToolName:      "lookup",
+				AuthTokens:    map[string]string{"Authorization": "secret"},
+				TemporaryTool: ultravox.NewHTTPToolWithBearerAuth("lookup", "Look things up", "https://example.com", "GET"),
+			}},
+			Medium: &ultravox.CallMedium{SIP: &ultravox.SIPMedium{Outgoing: &ultravox.SIPOutgoing{
+				To: "sip:a@example.com", Headers: map[string]string{"X-Base": "1"},
+			}}},
+			RecordingEnabled:     true,
+			FirstSpeakerSettings: ultravox.UserFirstSpeaker(time.Second, "Hello?", ""),
+			VadSettings:          ultravox.NewVadSettings(),
+			Metadata:             map[string]string{"team": "base"},
+			DataConnection:       ultravox.NewDataConnectionConfig("wss://example.com", 8000),
+			TemplateContext:      &ultravox.TemplateContext{UserFirstname: "Ada"},
+		},
+	}
+}
+
+func TestConfig_Clone(t *testing.T) {
+	original := fullConfig()
+	clone := original.Clone()
+	require.Equal(t, original, clone)
+
+	clone.Headers.Set("X-Tenant", "changed")
+	clone.RetryPolicy.MaxAttempts = 10
+	clone.ExternalVoice.ElevenLabs.VoiceID = "changed"
+	clone.ExternalVoice.ElevenLabs.PronunciationDictionaries[0].DictionaryID = "changed"
+	clone.InitialMessages[0].Text = "changed"
+	clone.InactivityMessages[0].Message = "changed"
+	clone.SelectedTools[0].AuthTokens["Authorization"] = "changed"
+	clone.SelectedTools[0].TemporaryTool.Description = "changed"
+	clone.SelectedTools[0].TemporaryTool.Requirements.HTTPSecurityOptions.Options[0].Requirements["Authorization"].HTTPAuth.Scheme = "changed"
+	clone.Medium.SIP.Outgoing.To = "changed"
+	clone.Medium.SIP.Outgoing.Headers["X-Base"] = "changed"
+	clone.FirstSpeakerSettings.User.Fallback.Text = "changed"
+	clone.VadSettings.FrameActivationThreshold = 0.9
+	clone.Metadata["team"] = "changed"
+	clone.DataConnection.AudioConfig.SampleRate = 16000
+	clone.TemplateContext.UserFirstname = "changed"
+
+	assert.Equal(t, fullConfig(), original, "mutating the clone must not affect the original")
+
+	t.Run("Zero value", func(t *testing.T) {
+		assert.Equal(t, ultravox.Config{}, ultravox.Config{}.Clone())
+	})
+}
+
+func TestConfig_Merge(t *testing.T) {
+	base := fullConfig()
+	tenant := ultravox.Config{
+		APIKey:             "tenant-key",
+		Headers:            http.Header{"X-Region": {"eu"}},
+		MaxDurationCeiling: time.Hour,
+		CallRequest: ultravox.CallRequest{
+			SystemPrompt:    "tenant prompt",
+			InitialMessages: []ultravox.Message{ultravox.NewAgentMessage("Welcome", ultravox.OutputMediumVoice)},
+			SelectedTools:   []ultravox.SelectedTool{{ToolName: "tenantTool"}},
+			VadSettings:     &ultravox.VadSettings{FrameActivationThreshold: 0.5},
+			Metadata:        map[string]string{"tenant": "acme"},
+		},
+	}
+
+	merged := base.Merge(tenant)
+
+	// Non-zero fields from the tenant win
+	assert.Equal(t, "tenant-key", merged.APIKey)
+	assert.Equal(t, time.Hour, merged.MaxDurationCeiling)
+	assert.Equal(t, "tenant prompt", merged.SystemPrompt)
+	assert.Equal(t, &ultravox.VadSettings{FrameActivationThreshold: 0.5}, merged.VadSettings)
+
+	// Zero fields keep the base value
+	assert.Equal(t, "https://base.example.com", merged.APIBaseURL)
+	assert.Equal(t, "Mark", merged.Voice)
+	assert.True(t, merged.RecordingEnabled)
+	assert.Equal(t, base.Medium, merged.Medium)
+
+	// Slices append and maps merge
+	require.Len(t, merged.InitialMessages, 2)
+	assert.Equal(t, "Welcome", merged.InitialMessages[1].Text)
+	require.Len(t, merged.SelectedTools, 2)
+	assert.Equal(t, "tenantTool", merged.SelectedTools[1].ToolName)
+	assert.Equal(t, map[string]string{"team": "base", "tenant": "acme"}, merged.Metadata)
+	assert.Equal(t, "base", merged.Headers.Get("X-Tenant"))
+	assert.Equal(t, "eu", merged.Headers.Get("X-Region"))
+
+	t.Run("Inputs are not aliased", func(t *testing.T) {
+		merged.Metadata["team"] = "changed"
+		merged.Headers.Set("X-Region", "us")
+		merged.VadSettings.FrameActivationThreshold = 0.1
+		merged.Medium.SIP.Outgoing.To = "changed"
+		merged.InitialMessages[0].Text = "changed"
+
+		assert.Equal(t, fullConfig(), base)
+		assert.Equal(t, "eu", tenant.Headers.Get("X-Region"))
+		assert.Equal(t, 0.5, tenant.VadSettings.FrameActivationThreshold)
+		assert.Equal(t, map[string]string{"tenant": "acme"}, tenant.Metadata)
+	})
+
+	t.Run("Appending does not share backing arrays", func(t *testing.T) {
+		base := ultravox.Config{CallRequest: ultravox.CallRequest{
+			SelectedTools: make([]ultravox.SelectedTool, 1, 4),
+		}}
+		a := base.Merge(ultravox.Config{CallRequest: ultravox.CallRequest{SelectedTools: []ultravox.SelectedTool{{ToolName: "a"}}}})
+		b := base.Merge(ultravox.Config{CallRequest: ultravox.CallRequest{SelectedTools: []ultravox.SelectedTool{{ToolName: "b"}}}})
+		assert.Equal(t, "a", a.SelectedTools[1].ToolName)
+		assert.Equal(t, "b", b.SelectedTools[1].ToolName)
+	})
 }
 
 func TestHelperFunctions(t *testing.T) {
@@ -0,0 +1,156 @@
+package ultravox
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig is the YAML schema read by LoadConfigFromFile. Client settings use
+// snake_case keys; call defaults sit alongside them using the CallRequest keys.
+type fileConfig struct {
+	APIKey      string           `yaml:"api_key"`
+	APIBaseURL  string           `yaml:"api_base_url,omitempty"`
+	HTTPTimeout UltravoxDuration `yaml:"http_timeout,omitempty"`
+	CallRequest `yaml:",inline"`
+}
+
+// LoadConfigFromFile reads client settings and call defaults from a YAML file
+// and returns them as options for NewClient. Unknown keys are rejected so that
+// typos surface immediately, and api_key must be set.
+func LoadConfigFromFile(path string) ([]Option, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var fc fileConfig
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&fc); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if fc.APIKey == "" {
+		return nil, fmt.Errorf("config file %s: api_key is required", path)
+	}
+
+	opts := []Option{WithAPIKey(fc.APIKey)}
+	if fc.APIBaseURL != "" {
+		opts = append(opts, WithAPIBaseURL(fc.APIBaseURL))
+	}
+	if fc.HTTPTimeout != 0 {
+		opts = append(opts, WithHTTPTimeout(time.Duration(fc.HTTPTimeout)))
+	}
+	opts = append(opts, withCallDefaults(fc.CallRequest))
+
+	return opts, nil
+}
+
+// LoadCallRequest decodes a YAML call request from r, such as one written by
+// SaveYAML. Unknown keys are rejected.
+func LoadCallRequest(r io.Reader) (*CallRequest, error) {
+	var request CallRequest
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&request); err != nil {
+		return nil, fmt.Errorf("failed to parse call request: %w", err)
+	}
+	return &request, nil
+}
+
+// SaveYAML writes the call request to w as YAML
+func (r *CallRequest) SaveYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("failed to encode call request: %w", err)
+	}
+	return enc.Close()
+}
+
+// LoadConfigFromEnv returns options for the ULTRAVOX_* environment variables
+// that are set: ULTRAVOX_API_KEY, ULTRAVOX_API_BASE_URL, ULTRAVOX_HTTP_TIMEOUT,
+// ULTRAVOX_MODEL, ULTRAVOX_VOICE, ULTRAVOX_SYSTEM_PROMPT, ULTRAVOX_TEMPERATURE,
+// ULTRAVOX_LANGUAGE_HINT, ULTRAVOX_JOIN_TIMEOUT, ULTRAVOX_MAX_DURATION and
+// ULTRAVOX_RECORDING_ENABLED.
+func LoadConfigFromEnv() ([]Option, error) {
+	var opts []Option
+
+	if v, ok := os.LookupEnv("ULTRAVOX_API_KEY"); ok {
+		opts = append(opts, WithAPIKey(v))
+	}
+	if v, ok := os.LookupEnv("ULTRAVOX_API_BASE_URL"); ok {
+		opts = append(opts, WithAPIBaseURL(v))
+	}
+	if v, ok := os.LookupEnv("ULTRAVOX_MODEL"); ok {
+		opts = append(opts, WithModel(v))
+	}
+	if v, ok := os.LookupEnv("ULTRAVOX_VOICE"); ok {
+		opts = append(opts, WithVoice(v))
+	}
+	if v, ok := os.LookupEnv("ULTRAVOX_SYSTEM_PROMPT"); ok {
+		opts = append(opts, WithSystemPrompt(v))
+	}
+	if v, ok := os.LookupEnv("ULTRAVOX_LANGUAGE_HINT"); ok {
+		opts = append(opts, WithLanguageHint(v))
+	}
+
+	if v, ok := os.LookupEnv("ULTRAVOX_TEMPERATURE"); ok {
+		temperature, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ULTRAVOX_TEMPERATURE: %w", err)
+		}
+		opts = append(opts, WithTemperature(temperature))
+	}
+
+	if v, ok := os.LookupEnv("ULTRAVOX_RECORDING_ENABLED"); ok {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ULTRAVOX_RECORDING_ENABLED: %w", err)
+		}
+		opts = append(opts, WithRecordingEnabled(enabled))
+	}
+
+	durations := []struct {
+		name  string
+		apply func(time.Duration) Option
+	}{
+		{"ULTRAVOX_HTTP_TIMEOUT", WithHTTPTimeout},
+		{"ULTRAVOX_JOIN_TIMEOUT", WithJoinTimeout},
+		{"ULTRAVOX_MAX_DURATION", WithMaxDuration},
+	}
+	for _, d := range durations {
+		v, ok := os.LookupEnv(d.name)
+		if !ok {
+			continue
+		}
+		parsed, err := parseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+		opts = append(opts, d.apply(time.Duration(parsed)))
+	}
+
+	return opts, nil
+}
+
+// withCallDefaults overlays every field set in defaults onto the client's call
+// configuration, leaving the remaining built-in defaults in place
+func withCallDefaults(defaults CallRequest) Option {
+	return func(c *Config) {
+		dst := reflect.ValueOf(&c.CallRequest).Elem()
+		src := reflect.ValueOf(defaults)
+		for i := 0; i < src.NumField(); i++ {
+			if !src.Field(i).IsZero() {
+				dst.Field(i).Set(src.Field(i))
+			}
+		}
+	}
+}
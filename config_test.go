@@ -0,0 +1,156 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureCallRequest issues a call with client and returns the decoded request body
+func captureCallRequest(t *testing.T, client *ultravox.Client) (map[string]interface{}, *http.Request) {
+	t.Helper()
+
+	var body map[string]interface{}
+	var captured *http.Request
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			captured = req
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	})
+
+	_, err := client.Call(context.Background())
+	require.NoError(t, err)
+	return body, captured
+}
+
+func TestLoadConfigFromFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{
+			name: "Valid config",
+			yaml: `
+api_key: file-key
+api_base_url: https://proxy.example.com/api
+http_timeout: 30s
+systemPrompt: You are a receptionist.
+voice: Jessica
+maxDuration: 5m
+selectedTools:
+  - toolName: hangUp
+`,
+		},
+		{
+			name:    "Missing API key",
+			yaml:    "voice: Jessica\n",
+			wantErr: "api_key is required",
+		},
+		{
+			name:    "Unknown key",
+			yaml:    "api_key: file-key\nvoise: Jessica\n",
+			wantErr: "voise",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ultravox.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.yaml), 0o600))
+
+			opts, err := ultravox.LoadConfigFromFile(path)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			body, req := captureCallRequest(t, ultravox.NewClient(opts...))
+			assert.Equal(t, "file-key", req.Header.Get("X-API-Key"))
+			assert.Equal(t, "proxy.example.com", req.URL.Host)
+			assert.Equal(t, "You are a receptionist.", body["systemPrompt"])
+			assert.Equal(t, "Jessica", body["voice"])
+			assert.Equal(t, "300s", body["maxDuration"])
+			assert.Equal(t, ultravox.DefaultModel, body["model"], "unset fields keep their defaults")
+			assert.Len(t, body["selectedTools"], 1)
+		})
+	}
+
+	t.Run("Missing file", func(t *testing.T) {
+		_, err := ultravox.LoadConfigFromFile(filepath.Join(t.TempDir(), "missing.yaml"))
+		assert.Error(t, err)
+	})
+}
+
+func TestLoadCallRequest(t *testing.T) {
+	t.Run("Duration", func(t *testing.T) {
+		request, err := ultravox.LoadCallRequest(bytes.NewBufferString("voice: Jessica\nmaxDuration: 5m\n"))
+		require.NoError(t, err)
+		assert.Equal(t, "Jessica", request.Voice)
+		assert.Equal(t, ultravox.UltravoxDuration(5*time.Minute), request.MaxDuration)
+	})
+
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := ultravox.LoadCallRequest(bytes.NewBufferString("voise: Jessica\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "voise")
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		var original ultravox.CallRequest
+		ultravox.WithCallSystemPrompt("You are a receptionist.")(&original)
+		ultravox.WithCallMaxDuration(90 * time.Second)(&original)
+		ultravox.WithCallJoinTimeout(1500 * time.Millisecond)(&original)
+
+		var buf bytes.Buffer
+		require.NoError(t, original.SaveYAML(&buf))
+		assert.Contains(t, buf.String(), "maxDuration: 90s")
+
+		loaded, err := ultravox.LoadCallRequest(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, &original, loaded)
+	})
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	t.Setenv("ULTRAVOX_API_KEY", "env-key")
+	t.Setenv("ULTRAVOX_MODEL", "env-model")
+	t.Setenv("ULTRAVOX_VOICE", "env-voice")
+	t.Setenv("ULTRAVOX_TEMPERATURE", "0.4")
+	t.Setenv("ULTRAVOX_MAX_DURATION", "120")
+
+	opts, err := ultravox.LoadConfigFromEnv()
+	require.NoError(t, err)
+
+	body, req := captureCallRequest(t, ultravox.NewClient(opts...))
+	assert.Equal(t, "env-key", req.Header.Get("X-API-Key"))
+	assert.Equal(t, "env-model", body["model"])
+	assert.Equal(t, "env-voice", body["voice"])
+	assert.Equal(t, 0.4, body["temperature"])
+	assert.Equal(t, "120s", body["maxDuration"])
+
+	t.Run("Invalid value", func(t *testing.T) {
+		t.Setenv("ULTRAVOX_TEMPERATURE", "warm")
+
+		_, err := ultravox.LoadConfigFromEnv()
+		assert.ErrorContains(t, err, "ULTRAVOX_TEMPERATURE")
+	})
+}
@@ -0,0 +1,66 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// CorrelationIDMetadataKey is the call metadata key that holds the correlation
+// ID set by WithCallCorrelationID. The API has no correlation ID field of its
+// own, so the ID travels in the call's metadata, which it echoes back.
+const CorrelationIDMetadataKey = "correlationId"
+
+// WithCallCorrelationID tags the call with id, typically the caller's own
+// request ID, so that it can be found again with GetCallByCorrelationID. It
+// adds to the call's metadata, so apply it after WithCallMetadata.
+func WithCallCorrelationID(id string) CallOption {
+	return func(r *CallRequest) {
+		// Copy so that a map passed to WithCallMetadata is left untouched
+		metadata := maps.Clone(r.Metadata)
+		if metadata == nil {
+			metadata = make(map[string]string, 1)
+		}
+		metadata[CorrelationIDMetadataKey] = id
+		r.Metadata = metadata
+	}
+}
+
+// CorrelationID returns the correlation ID the call was created with, or an
+// empty string if it has none
+func (c *Call) CorrelationID() string {
+	return c.Metadata[CorrelationIDMetadataKey]
+}
+
+// GetCallByCorrelationID returns the call created with the correlation ID id.
+// The API cannot search by metadata, so the account's calls are listed page by
+// page until one matches; opts such as WithPageSize apply to each page. It
+// returns ErrNotFound if no call has the ID.
+func (c *Client) GetCallByCorrelationID(ctx context.Context, id string, opts ...ListOption) (*Call, error) {
+	if id == "" {
+		return nil, fmt.Errorf("correlation ID is required")
+	}
+	opts = slices.Clip(opts)
+
+	for cursor := ""; ; {
+		pageOpts := opts
+		if cursor != "" {
+			pageOpts = append(pageOpts, WithCursor(cursor))
+		}
+
+		page, err := c.ListCalls(ctx, pageOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range page.Results {
+			if page.Results[i].CorrelationID() == id {
+				return &page.Results[i], nil
+			}
+		}
+		if cursor = page.NextCursor(); cursor == "" {
+			return nil, fmt.Errorf("call with correlation ID %q: %w", id, ErrNotFound)
+		}
+	}
+}
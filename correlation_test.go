@@ -0,0 +1,92 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCallCorrelationID(t *testing.T) {
+	metadata := map[string]string{"team": "support"}
+
+	var body map[string]interface{}
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"callId": "call-123",
+					"joinUrl": "wss://example.com/join",
+					"metadata": {"team": "support", "correlationId": "req-42"}
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	call, err := client.Call(context.Background(),
+		ultravox.WithCallMetadata(metadata),
+		ultravox.WithCallCorrelationID("req-42"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", call.CorrelationID())
+	assert.Equal(t, map[string]interface{}{
+		"team":          "support",
+		"correlationId": "req-42",
+	}, body["metadata"])
+	assert.NotContains(t, metadata, ultravox.CorrelationIDMetadataKey, "the caller's metadata is not modified")
+}
+
+func TestClient_GetCallByCorrelationID(t *testing.T) {
+	pages := map[string]string{
+		"": `{
+			"results": [{"callId": "call-1", "metadata": {"correlationId": "req-1"}}, {"callId": "call-2"}],
+			"next": "https://api.ultravox.ai/api/calls?cursor=page2"
+		}`,
+		"page2": `{
+			"results": [{"callId": "call-3", "metadata": {"correlationId": "req-3"}}]
+		}`,
+	}
+
+	var requests int
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/calls", req.URL.Path)
+			assert.Equal(t, "50", req.URL.Query().Get("pageSize"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(pages[req.URL.Query().Get("cursor")])),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	call, err := client.GetCallByCorrelationID(context.Background(), "req-3", ultravox.WithPageSize(50))
+	require.NoError(t, err)
+	assert.Equal(t, "call-3", call.CallID)
+	assert.Equal(t, 2, requests)
+
+	requests = 0
+	_, err = client.GetCallByCorrelationID(context.Background(), "req-missing", ultravox.WithPageSize(50))
+	assert.ErrorIs(t, err, ultravox.ErrNotFound)
+	assert.Equal(t, 2, requests)
+
+	_, err = client.GetCallByCorrelationID(context.Background(), "")
+	assert.Error(t, err)
+}
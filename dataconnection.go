@@ -0,0 +1,115 @@
+package ultravox
+
+import (
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+// DataConnectionAudio is a frame of call audio pushed over a data connection.
+// In mixed mode Mixed holds both parties as mono PCM; in separated mode the
+// stereo frame is split into User and Agent mono PCM.
+type DataConnectionAudio struct {
+	Mixed []byte
+	User  []byte
+	Agent []byte
+}
+
+// DataConnectionServer receives the data connection Ultravox opens to the
+// WebSocket URL in DataConnectionConfig. It is an http.Handler: mount it at
+// that URL and set the callbacks for the messages you need. Callbacks for one
+// connection are called sequentially from the goroutine serving it.
+type DataConnectionServer struct {
+	// ChannelMode must match the channel mode in the call's DataConnectionConfig.
+	// CHANNEL_MODE_SEPARATED audio is split per speaker; anything else is
+	// delivered as mixed audio.
+	ChannelMode ChannelModeType
+
+	// OnTranscript is called for each transcript message
+	OnTranscript func(*TranscriptEvent)
+
+	// OnAudio is called for each audio frame
+	OnAudio func(DataConnectionAudio)
+
+	// OnEvent is called for every other data message, such as state changes
+	OnEvent func(Event)
+
+	// Upgrader upgrades incoming requests; the zero value is used if unset
+	Upgrader websocket.Upgrader
+}
+
+// ServeHTTP upgrades the request to a WebSocket and dispatches messages to the
+// callbacks until Ultravox closes the connection
+func (s *DataConnectionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied with an HTTP error
+		return
+	}
+	defer conn.Close()
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		switch messageType {
+		case websocket.TextMessage:
+			s.dispatchEvent(data)
+		case websocket.BinaryMessage:
+			s.dispatchAudio(data)
+		}
+	}
+}
+
+// ListenAndServe serves data connections on addr at every path
+func (s *DataConnectionServer) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s)
+}
+
+// dispatchEvent decodes a data message and passes it to the matching callback.
+// Messages that cannot be decoded are dropped, as they are by Session.
+func (s *DataConnectionServer) dispatchEvent(data []byte) {
+	ev, err := DecodeEvent(data)
+	if err != nil {
+		return
+	}
+
+	if transcript, ok := ev.(*TranscriptEvent); ok {
+		if s.OnTranscript != nil {
+			s.OnTranscript(transcript)
+		}
+		return
+	}
+	if s.OnEvent != nil {
+		s.OnEvent(ev)
+	}
+}
+
+// dispatchAudio passes an audio frame to OnAudio, splitting it per speaker in separated mode
+func (s *DataConnectionServer) dispatchAudio(data []byte) {
+	if s.OnAudio == nil {
+		return
+	}
+	if s.ChannelMode != ChannelModeSeparated {
+		s.OnAudio(DataConnectionAudio{Mixed: data})
+		return
+	}
+
+	user, agent := deinterleaveStereo(data)
+	s.OnAudio(DataConnectionAudio{User: user, Agent: agent})
+}
+
+// deinterleaveStereo splits interleaved 16-bit stereo PCM into its left (user)
+// and right (agent) channels. A trailing partial sample pair is discarded.
+func deinterleaveStereo(data []byte) (left, right []byte) {
+	frames := len(data) / 4
+	left = make([]byte, 0, frames*2)
+	right = make([]byte, 0, frames*2)
+	for i := 0; i < frames*4; i += 4 {
+		left = append(left, data[i], data[i+1])
+		right = append(right, data[i+2], data[i+3])
+	}
+	return left, right
+}
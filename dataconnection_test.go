@@ -0,0 +1,123 @@
+package ultravox_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pushDataConnection connects to server as Ultravox would, sends each message
+// and waits for the handler to finish
+func pushDataConnection(t *testing.T, server *ultravox.DataConnectionServer, messages func(conn *websocket.Conn)) {
+	t.Helper()
+
+	done := make(chan struct{})
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), nil)
+	require.NoError(t, err)
+
+	go func() {
+		defer close(done)
+		// The server never writes, so this returns once it closes the connection
+		conn.ReadMessage()
+	}()
+
+	messages(conn)
+	require.NoError(t, conn.WriteMessage(websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("data connection was not closed")
+	}
+	conn.Close()
+}
+
+func TestDataConnectionServer(t *testing.T) {
+	t.Run("Mixed", func(t *testing.T) {
+		var mu sync.Mutex
+		var transcripts []*ultravox.TranscriptEvent
+		var events []ultravox.Event
+		var audio []ultravox.DataConnectionAudio
+
+		server := &ultravox.DataConnectionServer{
+			ChannelMode: ultravox.ChannelModeMixed,
+			OnTranscript: func(ev *ultravox.TranscriptEvent) {
+				mu.Lock()
+				defer mu.Unlock()
+				transcripts = append(transcripts, ev)
+			},
+			OnEvent: func(ev ultravox.Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				events = append(events, ev)
+			},
+			OnAudio: func(frame ultravox.DataConnectionAudio) {
+				mu.Lock()
+				defer mu.Unlock()
+				audio = append(audio, frame)
+			},
+		}
+
+		pushDataConnection(t, server, func(conn *websocket.Conn) {
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"transcript","role":"user","text":"Hello","final":true}`))
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"state","state":"speaking"}`))
+			conn.WriteMessage(websocket.TextMessage, []byte(`not json`))
+			conn.WriteMessage(websocket.BinaryMessage, []byte{0x01, 0x02, 0x03, 0x04})
+		})
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, transcripts, 1)
+		assert.Equal(t, "Hello", transcripts[0].Text)
+		assert.True(t, transcripts[0].Final)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, &ultravox.StateEvent{State: "speaking"}, events[0])
+
+		assert.Equal(t, []ultravox.DataConnectionAudio{{Mixed: []byte{0x01, 0x02, 0x03, 0x04}}}, audio)
+	})
+
+	t.Run("Separated", func(t *testing.T) {
+		var mu sync.Mutex
+		var audio []ultravox.DataConnectionAudio
+		server := &ultravox.DataConnectionServer{
+			ChannelMode: ultravox.ChannelModeSeparated,
+			OnAudio: func(frame ultravox.DataConnectionAudio) {
+				mu.Lock()
+				defer mu.Unlock()
+				audio = append(audio, frame)
+			},
+		}
+
+		pushDataConnection(t, server, func(conn *websocket.Conn) {
+			// Two stereo samples followed by a partial sample that is dropped
+			conn.WriteMessage(websocket.BinaryMessage, []byte{0x01, 0x02, 0xa1, 0xa2, 0x03, 0x04, 0xa3, 0xa4, 0x05})
+		})
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []ultravox.DataConnectionAudio{{
+			User:  []byte{0x01, 0x02, 0x03, 0x04},
+			Agent: []byte{0xa1, 0xa2, 0xa3, 0xa4},
+		}}, audio)
+	})
+
+	t.Run("Callbacks are optional", func(t *testing.T) {
+		pushDataConnection(t, &ultravox.DataConnectionServer{}, func(conn *websocket.Conn) {
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"transcript","role":"agent","delta":"Hi"}`))
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"state","state":"idle"}`))
+			conn.WriteMessage(websocket.BinaryMessage, []byte{0x01, 0x02})
+		})
+	})
+}
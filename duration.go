@@ -1,8 +1,10 @@
 package ultravox
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -17,14 +19,36 @@ func (d UltravoxDuration) String() string {
 	return time.Duration(d).String()
 }
 
-// formatDuration is a helper that formats the duration as a string in seconds
+// IsZero reports whether the duration is zero
+func (d UltravoxDuration) IsZero() bool {
+	return d == 0
+}
+
+// Abs returns the absolute value of the duration
+func (d UltravoxDuration) Abs() UltravoxDuration {
+	return UltravoxDuration(time.Duration(d).Abs())
+}
+
+// Milliseconds returns the duration as an integer millisecond count
+func (d UltravoxDuration) Milliseconds() int64 {
+	return time.Duration(d).Milliseconds()
+}
+
+// Add returns the sum of d and other
+func (d UltravoxDuration) Add(other UltravoxDuration) UltravoxDuration {
+	return d + other
+}
+
+// Sub returns the difference d-other
+func (d UltravoxDuration) Sub(other UltravoxDuration) UltravoxDuration {
+	return d - other
+}
+
+// formatDuration is a helper that formats the duration as a string in seconds.
+// Fixed-point notation is used so that tiny values never come out in
+// exponent form, which the API does not accept.
 func (d UltravoxDuration) formatDuration() string {
-	seconds := time.Duration(d).Seconds()
-	// Format with no decimal places if it's a whole number
-	if seconds == float64(int64(seconds)) {
-		return fmt.Sprintf("%.0fs", seconds)
-	}
-	return fmt.Sprintf("%gs", seconds)
+	return strconv.FormatFloat(time.Duration(d).Seconds(), 'f', -1, 64) + "s"
 }
 
 // MarshalJSON converts the duration to a string in seconds like "60s"
@@ -36,20 +60,39 @@ func (d UltravoxDuration) MarshalJSON() ([]byte, error) {
 // supporting multiple formats:
 // - Duration strings ("30s", "1m30s", etc.)
 // - Numeric strings ("30") as seconds
+//
+// Negative durations are rejected.
 func parseDuration(s string) (UltravoxDuration, error) {
 	// Try parsing as duration string first ("30s", "1m", etc.)
 	if parsed, err := time.ParseDuration(s); err == nil {
+		if parsed < 0 {
+			return 0, fmt.Errorf("duration must not be negative, got %q", s)
+		}
 		return UltravoxDuration(parsed), nil
 	}
 
 	// Try parsing as numeric string ("30")
 	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
-		return UltravoxDuration(time.Duration(seconds * float64(time.Second))), nil
+		return durationFromSeconds(seconds)
 	}
 
 	return 0, fmt.Errorf("invalid duration format: %q", s)
 }
 
+// durationFromSeconds converts a number of seconds to a duration, rejecting
+// values that are negative, not finite or too large to represent
+func durationFromSeconds(seconds float64) (UltravoxDuration, error) {
+	switch {
+	case math.IsNaN(seconds) || math.IsInf(seconds, 0):
+		return 0, fmt.Errorf("duration must be finite, got %v", seconds)
+	case seconds < 0:
+		return 0, fmt.Errorf("duration must not be negative, got %vs", seconds)
+	case seconds >= float64(math.MaxInt64)/float64(time.Second):
+		return 0, fmt.Errorf("duration %vs is out of range", seconds)
+	}
+	return UltravoxDuration(time.Duration(seconds * float64(time.Second))), nil
+}
+
 // UnmarshalJSON converts JSON data to duration supporting multiple formats:
 // - Numbers (30) as seconds
 // - Numeric strings ("30") as seconds
@@ -63,7 +106,11 @@ func (d *UltravoxDuration) UnmarshalJSON(data []byte) error {
 	switch v := rawValue.(type) {
 	case float64:
 		// Direct number (30)
-		*d = UltravoxDuration(time.Duration(v * float64(time.Second)))
+		parsed, err := durationFromSeconds(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
 		return nil
 
 	case string:
@@ -100,3 +147,57 @@ func (d *UltravoxDuration) UnmarshalYAML(value *yaml.Node) error {
 	*d = parsed
 	return nil
 }
+
+// MarshalText converts the duration to a string in seconds like "60s"
+func (d UltravoxDuration) MarshalText() ([]byte, error) {
+	return []byte(d.formatDuration()), nil
+}
+
+// UnmarshalText parses a duration string or a number of seconds
+func (d *UltravoxDuration) UnmarshalText(text []byte) error {
+	parsed, err := parseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value stores the duration in a database as an integer number of nanoseconds
+func (d UltravoxDuration) Value() (driver.Value, error) {
+	return int64(d), nil
+}
+
+// Scan reads a duration from a database column holding integer nanoseconds,
+// floating-point seconds or a duration string. NULL scans as zero.
+func (d *UltravoxDuration) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = 0
+		return nil
+
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("duration must not be negative, got %dns", v)
+		}
+		*d = UltravoxDuration(v)
+		return nil
+
+	case float64:
+		parsed, err := durationFromSeconds(v)
+		if err != nil {
+			return err
+		}
+		*d = parsed
+		return nil
+
+	case string:
+		return d.UnmarshalText([]byte(v))
+
+	case []byte:
+		return d.UnmarshalText(v)
+
+	default:
+		return fmt.Errorf("cannot scan %T into UltravoxDuration", src)
+	}
+}
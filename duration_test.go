@@ -0,0 +1,194 @@
+package ultravox_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUltravoxDuration_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    ultravox.UltravoxDuration
+		wantErr string
+	}{
+		{name: "Number", json: `30`, want: ultravox.UltravoxDuration(30 * time.Second)},
+		{name: "Fractional number", json: `0.5`, want: ultravox.UltravoxDuration(500 * time.Millisecond)},
+		{name: "Numeric string", json: `"30"`, want: ultravox.UltravoxDuration(30 * time.Second)},
+		{name: "Duration string", json: `"1m30s"`, want: ultravox.UltravoxDuration(90 * time.Second)},
+		{name: "Zero", json: `0`, want: 0},
+		{name: "Negative number", json: `-5`, wantErr: "negative"},
+		{name: "Negative string", json: `"-5s"`, wantErr: "negative"},
+		{name: "Invalid string", json: `"abc"`, wantErr: "invalid duration format"},
+		{name: "NaN", json: `"NaN"`, wantErr: "finite"},
+		{name: "Infinity", json: `"Inf"`, wantErr: "finite"},
+		{name: "Overflow number", json: `1e308`, wantErr: "out of range"},
+		{name: "Overflow string", json: `"1e308"`, wantErr: "out of range"},
+		{name: "Wrong type", json: `true`, wantErr: "number or string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d ultravox.UltravoxDuration
+			err := json.Unmarshal([]byte(tt.json), &d)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d)
+		})
+	}
+}
+
+func TestUltravoxDuration_UnmarshalYAML(t *testing.T) {
+	var d ultravox.UltravoxDuration
+	require.NoError(t, yaml.Unmarshal([]byte(`1m`), &d))
+	assert.Equal(t, ultravox.UltravoxDuration(time.Minute), d)
+
+	assert.ErrorContains(t, yaml.Unmarshal([]byte(`-5`), &d), "negative")
+}
+
+func TestUltravoxDuration_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{0, `"0s"`},
+		{90 * time.Millisecond, `"0.09s"`},
+		{384 * time.Millisecond, `"0.384s"`},
+		{1500 * time.Millisecond, `"1.5s"`},
+		{time.Hour + 30*time.Minute, `"5400s"`},
+		{100 * time.Microsecond, `"0.0001s"`},
+		{time.Nanosecond, `"0.000000001s"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration.String(), func(t *testing.T) {
+			data, err := json.Marshal(ultravox.UltravoxDuration(tt.duration))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(data))
+
+			var decoded ultravox.UltravoxDuration
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, ultravox.UltravoxDuration(tt.duration), decoded)
+		})
+	}
+}
+
+func TestUltravoxDuration_Helpers(t *testing.T) {
+	tests := []struct {
+		name         string
+		d            ultravox.UltravoxDuration
+		other        ultravox.UltravoxDuration
+		isZero       bool
+		abs          ultravox.UltravoxDuration
+		milliseconds int64
+		sum          ultravox.UltravoxDuration
+		difference   ultravox.UltravoxDuration
+	}{
+		{
+			name:         "Zero",
+			isZero:       true,
+			other:        ultravox.UltravoxDuration(time.Second),
+			sum:          ultravox.UltravoxDuration(time.Second),
+			difference:   ultravox.UltravoxDuration(-time.Second),
+			milliseconds: 0,
+		},
+		{
+			name:         "Positive",
+			d:            ultravox.UltravoxDuration(384 * time.Millisecond),
+			other:        ultravox.UltravoxDuration(100 * time.Millisecond),
+			abs:          ultravox.UltravoxDuration(384 * time.Millisecond),
+			milliseconds: 384,
+			sum:          ultravox.UltravoxDuration(484 * time.Millisecond),
+			difference:   ultravox.UltravoxDuration(284 * time.Millisecond),
+		},
+		{
+			name:         "Negative",
+			d:            ultravox.UltravoxDuration(-1500 * time.Millisecond),
+			other:        ultravox.UltravoxDuration(-500 * time.Millisecond),
+			abs:          ultravox.UltravoxDuration(1500 * time.Millisecond),
+			milliseconds: -1500,
+			sum:          ultravox.UltravoxDuration(-2 * time.Second),
+			difference:   ultravox.UltravoxDuration(-time.Second),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.isZero, tt.d.IsZero())
+			assert.Equal(t, tt.abs, tt.d.Abs())
+			assert.Equal(t, tt.milliseconds, tt.d.Milliseconds())
+			assert.Equal(t, tt.sum, tt.d.Add(tt.other))
+			assert.Equal(t, tt.difference, tt.d.Sub(tt.other))
+		})
+	}
+}
+
+func TestUltravoxDuration_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     interface{}
+		want    ultravox.UltravoxDuration
+		wantErr string
+	}{
+		{name: "NULL", src: nil, want: 0},
+		{name: "Nanoseconds", src: int64(1500 * time.Millisecond), want: ultravox.UltravoxDuration(1500 * time.Millisecond)},
+		{name: "Seconds", src: 2.5, want: ultravox.UltravoxDuration(2500 * time.Millisecond)},
+		{name: "String", src: "1m30s", want: ultravox.UltravoxDuration(90 * time.Second)},
+		{name: "Bytes", src: []byte("30"), want: ultravox.UltravoxDuration(30 * time.Second)},
+		{name: "Negative nanoseconds", src: int64(-1), wantErr: "negative"},
+		{name: "Invalid string", src: "abc", wantErr: "invalid duration format"},
+		{name: "Unsupported type", src: true, wantErr: "cannot scan bool"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := ultravox.UltravoxDuration(time.Hour)
+			err := d.Scan(tt.src)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, d)
+		})
+	}
+}
+
+func TestUltravoxDuration_Value(t *testing.T) {
+	value, err := ultravox.UltravoxDuration(1500 * time.Millisecond).Value()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1500*time.Millisecond), value)
+
+	var scanned ultravox.UltravoxDuration
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, ultravox.UltravoxDuration(1500*time.Millisecond), scanned)
+}
+
+func TestUltravoxDuration_Text(t *testing.T) {
+	text, err := ultravox.UltravoxDuration(384 * time.Millisecond).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "0.384s", string(text))
+
+	var d ultravox.UltravoxDuration
+	require.NoError(t, d.UnmarshalText(text))
+	assert.Equal(t, ultravox.UltravoxDuration(384*time.Millisecond), d)
+
+	assert.Error(t, d.UnmarshalText([]byte("-5s")))
+
+	t.Run("JSON is unchanged", func(t *testing.T) {
+		data, err := json.Marshal(map[string]ultravox.UltravoxDuration{"delay": ultravox.UltravoxDuration(time.Second)})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"delay": "1s"}`, string(data))
+	})
+}
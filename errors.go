@@ -0,0 +1,110 @@
+package ultravox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrNotFound is returned when the requested resource does not exist
+var ErrNotFound = errors.New("resource not found")
+
+// ErrUnauthorized is returned when the API rejects the API key, either because
+// it is invalid (401) or lacks permission for the request (403)
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrRecordingUnavailable is returned when a call has no recording, usually
+// because recording was not enabled for it
+var ErrRecordingUnavailable = errors.New("call recording is not available")
+
+// ErrRecordingNotFound is an alias for ErrRecordingUnavailable
+var ErrRecordingNotFound = ErrRecordingUnavailable
+
+// ErrCircuitOpen is returned without contacting the API while the client's circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// ErrCallNotEnded is returned by Call.Duration for a call that is still in progress
+var ErrCallNotEnded = errors.New("call has not ended")
+
+// ErrJoinTimeout is returned by Join when the call's WebSocket cannot be
+// connected within the call's join timeout
+var ErrJoinTimeout = errors.New("timed out joining call")
+
+// ErrSessionClosed is returned by Session reads and writes after Close
+var ErrSessionClosed = errors.New("session closed")
+
+// ErrNoCallState is returned by CallStateAs when the call or message has no state
+var ErrNoCallState = errors.New("no call state")
+
+// ErrNoTimespan is returned by Message.Duration when the message has no timespan
+var ErrNoTimespan = errors.New("message has no timespan")
+
+// ErrReconnectBufferFull is returned by Session writes made while the session
+// is reconnecting once the bounded reconnect buffer is full
+var ErrReconnectBufferFull = errors.New("session reconnect buffer is full")
+
+// ErrInvalidWebhookSignature is returned by VerifyWebhookSignature when no
+// signature in the header matches the payload
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// ErrWebhookTimestampExpired is returned by VerifyWebhookSignature when a
+// webhook was signed too long ago, or too far in the future, to be trusted
+var ErrWebhookTimestampExpired = errors.New("webhook timestamp outside the allowed tolerance")
+
+// maxErrorBodySize limits how much of an error response body is retained
+const maxErrorBodySize = 64 << 10
+
+// APIError is returned when the Ultravox API responds with a non-success status
+type APIError struct {
+	StatusCode int
+	Message    string
+	Body       []byte
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API returned non-success status: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("API returned non-success status: %d: %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error matches one of the package's sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	default:
+		return false
+	}
+}
+
+// newAPIError builds an APIError from a non-success response, extracting the
+// message from the "detail" or "error" field of a JSON body when present
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(body) == 0 {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	var payload struct {
+		Detail string `json:"detail"`
+		Error  string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Detail != "" {
+			apiErr.Message = payload.Detail
+		} else {
+			apiErr.Message = payload.Error
+		}
+	}
+
+	return apiErr
+}
@@ -0,0 +1,128 @@
+package ultravox
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// EventType identifies the kind of a data message
+type EventType string
+
+// Predefined data message types
+const (
+	EventTypeTranscript    EventType = "transcript"
+	EventTypeState         EventType = "state"
+	EventTypeError         EventType = "error"
+	EventTypePlaybackClear EventType = "playback_clear_buffer"
+
+	// EventTypeReconnect is generated by Session, not sent by Ultravox
+	EventTypeReconnect EventType = "reconnect"
+)
+
+// Event is a JSON data message received from Ultravox during a call
+type Event interface {
+	EventType() EventType
+}
+
+// TranscriptEvent carries the text of an utterance, delivered incrementally until Final is set
+type TranscriptEvent struct {
+	Role    string           `json:"role"`
+	Medium  OutputMediumType `json:"medium,omitempty"`
+	Text    string           `json:"text,omitempty"`
+	Delta   string           `json:"delta,omitempty"`
+	Final   bool             `json:"final"`
+	Ordinal int              `json:"ordinal"`
+}
+
+// EventType returns EventTypeTranscript
+func (e *TranscriptEvent) EventType() EventType {
+	return EventTypeTranscript
+}
+
+// StateEvent reports a change in the agent's state, such as listening or speaking
+type StateEvent struct {
+	State string `json:"state"`
+}
+
+// EventType returns EventTypeState
+func (e *StateEvent) EventType() EventType {
+	return EventTypeState
+}
+
+// ErrorEvent reports an error raised by Ultravox during the call
+type ErrorEvent struct {
+	Error string `json:"error"`
+}
+
+// EventType returns EventTypeError
+func (e *ErrorEvent) EventType() EventType {
+	return EventTypeError
+}
+
+// PlaybackClearEvent asks the client to discard any agent audio it has buffered, typically after the user interrupts
+type PlaybackClearEvent struct{}
+
+// EventType returns EventTypePlaybackClear
+func (e *PlaybackClearEvent) EventType() EventType {
+	return EventTypePlaybackClear
+}
+
+// ReconnectEvent reports that a Session with WithSessionReconnect re-dialled
+// the call after its connection dropped. Messages sent by Ultravox while the
+// session was disconnected are lost.
+type ReconnectEvent struct {
+	// Attempt is the number of dials it took to reconnect, starting at 1
+	Attempt int
+
+	// Err is the error that dropped the previous connection
+	Err error
+}
+
+// EventType returns EventTypeReconnect
+func (e *ReconnectEvent) EventType() EventType {
+	return EventTypeReconnect
+}
+
+// RawEvent is a data message of a type without a dedicated struct, kept in its original JSON form
+type RawEvent struct {
+	Type EventType       `json:"type"`
+	Data json.RawMessage `json:"-"`
+}
+
+// EventType returns the message type discriminator
+func (e *RawEvent) EventType() EventType {
+	return e.Type
+}
+
+// DecodeEvent parses a data message into its concrete event type.
+// Messages of an unrecognised type are returned as a *RawEvent.
+func DecodeEvent(data []byte) (Event, error) {
+	var header struct {
+		Type EventType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to decode event: %w", err)
+	}
+	if header.Type == "" {
+		return nil, fmt.Errorf("event is missing a type")
+	}
+
+	var ev Event
+	switch header.Type {
+	case EventTypeTranscript:
+		ev = &TranscriptEvent{}
+	case EventTypeState:
+		ev = &StateEvent{}
+	case EventTypeError:
+		ev = &ErrorEvent{}
+	case EventTypePlaybackClear:
+		return &PlaybackClearEvent{}, nil
+	default:
+		return &RawEvent{Type: header.Type, Data: data}, nil
+	}
+
+	if err := json.Unmarshal(data, ev); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", header.Type, err)
+	}
+	return ev, nil
+}
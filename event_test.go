@@ -0,0 +1,74 @@
+package ultravox_test
+
+import (
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    ultravox.Event
+		wantErr bool
+	}{
+		{
+			name: "Transcript",
+			data: `{"type":"transcript","role":"agent","medium":"voice","delta":"Hel","final":false,"ordinal":3}`,
+			want: &ultravox.TranscriptEvent{Role: "agent", Medium: "voice", Delta: "Hel", Ordinal: 3},
+		},
+		{
+			name: "Final transcript",
+			data: `{"type":"transcript","role":"user","text":"Hello there","final":true}`,
+			want: &ultravox.TranscriptEvent{Role: "user", Text: "Hello there", Final: true},
+		},
+		{
+			name: "State",
+			data: `{"type":"state","state":"speaking"}`,
+			want: &ultravox.StateEvent{State: "speaking"},
+		},
+		{
+			name: "Error",
+			data: `{"type":"error","error":"upstream failure"}`,
+			want: &ultravox.ErrorEvent{Error: "upstream failure"},
+		},
+		{
+			name: "Playback clear",
+			data: `{"type":"playback_clear_buffer"}`,
+			want: &ultravox.PlaybackClearEvent{},
+		},
+		{
+			name: "Unknown type",
+			data: `{"type":"debug","message":"hi"}`,
+			want: &ultravox.RawEvent{Type: "debug", Data: []byte(`{"type":"debug","message":"hi"}`)},
+		},
+		{
+			name:    "Missing type",
+			data:    `{"state":"speaking"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid JSON",
+			data:    `{invalid`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev, err := ultravox.DecodeEvent([]byte(tt.data))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ev)
+			assert.Equal(t, tt.want.EventType(), ev.EventType())
+		})
+	}
+}
@@ -3,7 +3,6 @@ package main
 
 import (
 	"context"
-	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io/fs"
@@ -17,10 +16,10 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/paulgrammer/ultravox"
+	"github.com/paulgrammer/ultravox/audio"
 	"github.com/paulgrammer/ultravox/examples/webrtc/web"
 	"github.com/pion/rtp"
 	"github.com/pion/webrtc/v4"
-	"github.com/zaf/g711"
 )
 
 const (
@@ -64,27 +63,6 @@ type SDPMessage struct {
 	SDP  webrtc.SessionDescription `json:"sdp"`
 }
 
-// UltravoxEvent types
-type (
-	TranscriptEvent struct {
-		Type  string `json:"type"`
-		Role  string `json:"role"`
-		Final bool   `json:"final"`
-		Text  string `json:"text"`
-		Delta string `json:"delta"`
-	}
-
-	ErrorEvent struct {
-		Type  string `json:"type"`
-		Error string `json:"error"`
-	}
-
-	StateEvent struct {
-		Type  string `json:"type"`
-		State string `json:"state"`
-	}
-)
-
 // WebSocket upgrader for client connections
 var wsUpgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
@@ -406,21 +384,11 @@ func processAudioPacket(payload []byte, mimeType string) ([]byte, error) {
 	switch mimeType {
 	case webrtc.MimeTypePCMA:
 		// Convert A-law to PCM
-		pcmData := make([]byte, len(payload)*2)
-		for i, sample := range payload {
-			pcmSample := g711.DecodeAlawFrame(sample)
-			binary.LittleEndian.PutUint16(pcmData[i*2:], uint16(pcmSample))
-		}
-		return pcmData, nil
+		return audio.AlawToPCM16LE(payload), nil
 
 	case webrtc.MimeTypePCMU:
 		// Convert µ-law to PCM
-		pcmData := make([]byte, len(payload)*2)
-		for i, sample := range payload {
-			pcmSample := g711.DecodeUlawFrame(sample)
-			binary.LittleEndian.PutUint16(pcmData[i*2:], uint16(pcmSample))
-		}
-		return pcmData, nil
+		return audio.UlawToPCM16LE(payload), nil
 
 	default:
 		return nil, fmt.Errorf("unsupported codec: %s", mimeType)
@@ -535,14 +503,8 @@ func handleUltravoxWebSocket(uvConn *UltravoxConnection) {
 
 // processUltravoxAudio processes audio data from Ultravox and sends it to WebRTC
 func processUltravoxAudio(uvConn *UltravoxConnection, pcmData []byte, sequenceNumber *uint16, timestamp *uint32, ssrc uint32) {
-	// Convert from PCM 16-bit to PCMU (G.711 µ-law) using g711 library
-	muLawData := make([]byte, len(pcmData)/2)
-	for i := 0; i < len(pcmData)/2; i++ {
-		// Read 16-bit PCM sample (little-endian)
-		sample := int16(binary.LittleEndian.Uint16(pcmData[i*2:]))
-		// Convert to µ-law
-		muLawData[i] = g711.EncodeUlawFrame(sample)
-	}
+	// Convert from little-endian PCM 16-bit to PCMU (G.711 µ-law)
+	muLawData := audio.PCM16LEToUlaw(pcmData)
 
 	// Calculate timestamp increment (for 8kHz audio)
 	tsIncrement := uint32(len(muLawData))
@@ -570,19 +532,13 @@ func processUltravoxAudio(uvConn *UltravoxConnection, pcmData []byte, sequenceNu
 
 // handleUltravoxJsonMessage processes JSON messages from Ultravox and forwards them to the client
 func handleUltravoxJsonMessage(uvConn *UltravoxConnection, message []byte) {
-	var event map[string]interface{}
-	if err := json.Unmarshal(message, &event); err != nil {
-		log.Printf("Error parsing JSON: %v", err)
+	event, err := ultravox.DecodeEvent(message)
+	if err != nil {
+		log.Printf("Error parsing event: %v", err)
 		log.Println(string(message))
 		return
 	}
 
-	eventType, ok := event["type"].(string)
-	if !ok {
-		log.Printf("Unknown JSON message: %s", string(message))
-		return
-	}
-
 	// Forward the event to the client if the WebSocket connection is established
 	if uvConn.clientWs != nil {
 		if err := uvConn.clientWs.WriteMessage(websocket.TextMessage, message); err != nil {
@@ -591,36 +547,20 @@ func handleUltravoxJsonMessage(uvConn *UltravoxConnection, message []byte) {
 	}
 
 	// Process the event locally
-	switch eventType {
-	case "transcript":
-		var transcriptEvent TranscriptEvent
-		if err := json.Unmarshal(message, &transcriptEvent); err != nil {
-			log.Printf("Error parsing transcript event: %v", err)
-			return
-		}
-
-		if transcriptEvent.Final {
-			log.Printf("Transcript [%s]: %s", transcriptEvent.Role, transcriptEvent.Text)
+	switch ev := event.(type) {
+	case *ultravox.TranscriptEvent:
+		if ev.Final {
+			log.Printf("Transcript [%s]: %s", ev.Role, ev.Text)
 		}
 
-	case "error":
-		var errorEvent ErrorEvent
-		if err := json.Unmarshal(message, &errorEvent); err != nil {
-			log.Printf("Error parsing error event: %v", err)
-			return
-		}
-		log.Printf("Ultravox Error: %s", errorEvent.Error)
+	case *ultravox.ErrorEvent:
+		log.Printf("Ultravox Error: %s", ev.Error)
 
-	case "state":
-		var stateEvent StateEvent
-		if err := json.Unmarshal(message, &stateEvent); err != nil {
-			log.Printf("Error parsing state event: %v", err)
-			return
-		}
-		log.Printf("Ultravox State: %s", stateEvent.State)
+	case *ultravox.StateEvent:
+		log.Printf("Ultravox State: %s", ev.State)
 
 	default:
-		log.Printf("Received unknown event type: %s", eventType)
+		log.Printf("Received unknown event type: %s", ev.EventType())
 		log.Println(string(message))
 	}
 }
@@ -0,0 +1,78 @@
+package ultravox
+
+import (
+	"encoding/json"
+	"maps"
+)
+
+// ExperimentalSettings is a typed form of a call's experimental settings for
+// the keys this client knows about. Keys without a field can be set in Raw;
+// when both set the same key, the typed field wins. Pass it, or a pointer to
+// it, to WithCallExperimentalSettings.
+type ExperimentalSettings struct {
+	// DynamicSpeedModel lets the model adapt the agent's speaking rate to the user
+	DynamicSpeedModel bool `json:"dynamicSpeedModel,omitempty"`
+
+	// TTSLatency selects the text-to-speech latency mode
+	TTSLatency string `json:"ttsLatency,omitempty"`
+
+	// Raw holds experimental keys that have no typed field
+	Raw map[string]interface{} `json:"-"`
+}
+
+// experimentalSettingsFields has the typed fields of ExperimentalSettings
+// without its marshalling methods
+type experimentalSettingsFields ExperimentalSettings
+
+// MarshalJSON encodes the typed fields merged over Raw as a single object
+func (s ExperimentalSettings) MarshalJSON() ([]byte, error) {
+	fields, err := s.toMap()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// MarshalYAML encodes the settings as a single mapping, like MarshalJSON
+func (s ExperimentalSettings) MarshalYAML() (interface{}, error) {
+	return s.toMap()
+}
+
+// UnmarshalJSON decodes the known keys into their fields and keeps every other key in Raw
+func (s *ExperimentalSettings) UnmarshalJSON(data []byte) error {
+	var typed experimentalSettingsFields
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return err
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	delete(raw, "dynamicSpeedModel")
+	delete(raw, "ttsLatency")
+	if len(raw) > 0 {
+		typed.Raw = raw
+	}
+
+	*s = ExperimentalSettings(typed)
+	return nil
+}
+
+// toMap merges the typed fields over a copy of Raw
+func (s ExperimentalSettings) toMap() (map[string]interface{}, error) {
+	data, err := json.Marshal(experimentalSettingsFields(s))
+	if err != nil {
+		return nil, err
+	}
+	var typed map[string]interface{}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+
+	fields := maps.Clone(s.Raw)
+	if fields == nil {
+		fields = make(map[string]interface{}, len(typed))
+	}
+	maps.Copy(fields, typed)
+	return fields, nil
+}
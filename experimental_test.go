@@ -0,0 +1,71 @@
+package ultravox_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestExperimentalSettings(t *testing.T) {
+	settings := ultravox.ExperimentalSettings{
+		DynamicSpeedModel: true,
+		TTSLatency:        "low",
+		Raw: map[string]interface{}{
+			"ttsLatency":     "ignored",
+			"newFeatureFlag": 3,
+		},
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallExperimentalSettings(&settings)(&request)
+
+		data, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		var body struct {
+			ExperimentalSettings json.RawMessage `json:"experimentalSettings"`
+		}
+		require.NoError(t, json.Unmarshal(data, &body))
+		assert.JSONEq(t, `{"dynamicSpeedModel": true, "ttsLatency": "low", "newFeatureFlag": 3}`, string(body.ExperimentalSettings))
+
+		var decoded ultravox.ExperimentalSettings
+		require.NoError(t, json.Unmarshal(body.ExperimentalSettings, &decoded))
+		assert.Equal(t, ultravox.ExperimentalSettings{
+			DynamicSpeedModel: true,
+			TTSLatency:        "low",
+			Raw:               map[string]interface{}{"newFeatureFlag": float64(3)},
+		}, decoded)
+	})
+
+	t.Run("YAML", func(t *testing.T) {
+		data, err := yaml.Marshal(settings)
+		require.NoError(t, err)
+
+		var decoded map[string]interface{}
+		require.NoError(t, yaml.Unmarshal(data, &decoded))
+		assert.Equal(t, map[string]interface{}{"dynamicSpeedModel": true, "ttsLatency": "low", "newFeatureFlag": 3}, decoded)
+	})
+
+	t.Run("Untyped settings", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallExperimentalSettings(map[string]interface{}{"anything": "goes"})(&request)
+
+		data, err := json.Marshal(request)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), `"experimentalSettings":{"anything":"goes"}`)
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		config := ultravox.Config{}
+		ultravox.WithCallExperimentalSettings(&ultravox.ExperimentalSettings{Raw: map[string]interface{}{"a": 1}})(&config.CallRequest)
+
+		clone := config.Clone()
+		clone.ExperimentalSettings.(*ultravox.ExperimentalSettings).Raw["a"] = 2
+		assert.Equal(t, 1, config.ExperimentalSettings.(*ultravox.ExperimentalSettings).Raw["a"])
+	})
+}
@@ -0,0 +1,51 @@
+package ultravox
+
+import "net/http"
+
+// HTTPInterceptor wraps the requests the client sends, for example to add
+// headers, sign requests or inspect responses. Implementations call
+// next.RoundTrip to continue the chain and must not modify req in place;
+// clone it first when changing headers.
+type HTTPInterceptor interface {
+	Intercept(req *http.Request, next http.RoundTripper) (*http.Response, error)
+}
+
+// InterceptorFunc adapts an ordinary function to the HTTPInterceptor interface
+type InterceptorFunc func(req *http.Request, next http.RoundTripper) (*http.Response, error)
+
+// Intercept calls f(req, next)
+func (f InterceptorFunc) Intercept(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	return f(req, next)
+}
+
+// WithHTTPInterceptor adds an interceptor around the client's HTTP transport.
+// Interceptors run in reverse order of registration, so the last one added
+// sees each request first. They apply to the built-in HTTP client only, not
+// to one supplied through Client.WithHTTPClient.
+func WithHTTPInterceptor(i HTTPInterceptor) Option {
+	return func(c *Config) {
+		c.Interceptors = append(c.Interceptors, i)
+	}
+}
+
+// interceptorTransport runs an interceptor in front of the next transport
+type interceptorTransport struct {
+	interceptor HTTPInterceptor
+	next        http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *interceptorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.interceptor.Intercept(req, t.next)
+}
+
+// chainInterceptors wraps base with each interceptor in turn, so the last one ends up outermost
+func chainInterceptors(base http.RoundTripper, interceptors []HTTPInterceptor) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	for _, i := range interceptors {
+		base = &interceptorTransport{interceptor: i, next: base}
+	}
+	return base
+}
@@ -0,0 +1,54 @@
+package ultravox_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_HTTPInterceptors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-api-key", r.Header.Get("X-API-Key"))
+		assert.Equal(t, "Bearer gateway-token", r.Header.Get("Authorization"))
+		w.Header().Set("X-Request-ID", "req-789")
+		w.Write([]byte(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`))
+	}))
+	defer server.Close()
+
+	var order []string
+	var requestID string
+
+	auth := ultravox.InterceptorFunc(func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		order = append(order, "auth")
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", "Bearer gateway-token")
+		return next.RoundTrip(req)
+	})
+	logRequestID := ultravox.InterceptorFunc(func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		order = append(order, "log")
+		resp, err := next.RoundTrip(req)
+		if err == nil {
+			requestID = resp.Header.Get("X-Request-ID")
+		}
+		return resp, err
+	})
+
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithAPIBaseURL(server.URL),
+		ultravox.WithHTTPInterceptor(auth),
+		ultravox.WithHTTPInterceptor(logRequestID),
+	)
+
+	call, err := client.GetCall(context.Background(), "call-123")
+	require.NoError(t, err)
+	assert.Equal(t, "call-123", call.CallID)
+
+	assert.Equal(t, []string{"log", "auth"}, order)
+	assert.Equal(t, "req-789", requestID)
+}
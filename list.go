@@ -0,0 +1,54 @@
+package ultravox
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ListOption is a function that modifies the query parameters of a list request
+type ListOption func(url.Values)
+
+// WithCursor resumes listing from a cursor returned by a previous page
+func WithCursor(cursor string) ListOption {
+	return func(q url.Values) {
+		q.Set("cursor", cursor)
+	}
+}
+
+// WithPageSize sets the maximum number of results returned per page
+func WithPageSize(size int) ListOption {
+	return func(q url.Values) {
+		q.Set("pageSize", strconv.Itoa(size))
+	}
+}
+
+// WithSeverityFilter limits call events to those at or above the given severity
+func WithSeverityFilter(severity SeverityType) ListOption {
+	return func(q url.Values) {
+		q.Set("minimum_severity", string(severity))
+	}
+}
+
+// buildListURL appends the query parameters produced by opts to endpoint
+func buildListURL(endpoint string, opts []ListOption) string {
+	q := url.Values{}
+	for _, opt := range opts {
+		opt(q)
+	}
+	if len(q) == 0 {
+		return endpoint
+	}
+	return endpoint + "?" + q.Encode()
+}
+
+// cursorFromURL extracts the cursor parameter from a page link returned by the API
+func cursorFromURL(link string) string {
+	if link == "" {
+		return ""
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("cursor")
+}
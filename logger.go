@@ -0,0 +1,82 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"strings"
+)
+
+// Logger receives structured log output from the client.
+// Fields are alternating key/value pairs, as with log/slog.
+type Logger interface {
+	Debug(msg string, fields ...any)
+	Info(msg string, fields ...any)
+	Warn(msg string, fields ...any)
+	Error(msg string, fields ...any)
+}
+
+// WithLogger sets the logger used for request and response diagnostics
+func WithLogger(l Logger) Option {
+	return func(c *Config) {
+		c.Logger = l
+	}
+}
+
+// nopLogger discards all log output
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// stdLogger adapts a *log.Logger to the Logger interface
+type stdLogger struct {
+	l *log.Logger
+}
+
+// NewStdLogger returns a Logger that writes lines such as
+// "[INFO] received response status=200" to l
+func NewStdLogger(l *log.Logger) Logger {
+	return &stdLogger{l: l}
+}
+
+func (s *stdLogger) Debug(msg string, fields ...any) { s.print("DEBUG", msg, fields) }
+func (s *stdLogger) Info(msg string, fields ...any)  { s.print("INFO", msg, fields) }
+func (s *stdLogger) Warn(msg string, fields ...any)  { s.print("WARN", msg, fields) }
+func (s *stdLogger) Error(msg string, fields ...any) { s.print("ERROR", msg, fields) }
+
+// print formats the message and its key/value fields on a single line
+func (s *stdLogger) print(level, msg string, fields []any) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] %s", level, msg)
+	for i := 0; i < len(fields); i += 2 {
+		if i+1 < len(fields) {
+			fmt.Fprintf(&b, " %v=%v", fields[i], fields[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", fields[i])
+		}
+	}
+	s.l.Print(b.String())
+}
+
+// slogLogger adapts a *slog.Logger to the Logger interface
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// NewSlogLogger returns a Logger that writes to l at the matching slog level
+func NewSlogLogger(l *slog.Logger) Logger {
+	return &slogLogger{l: l}
+}
+
+func (s *slogLogger) Debug(msg string, fields ...any) { s.log(slog.LevelDebug, msg, fields) }
+func (s *slogLogger) Info(msg string, fields ...any)  { s.log(slog.LevelInfo, msg, fields) }
+func (s *slogLogger) Warn(msg string, fields ...any)  { s.log(slog.LevelWarn, msg, fields) }
+func (s *slogLogger) Error(msg string, fields ...any) { s.log(slog.LevelError, msg, fields) }
+
+func (s *slogLogger) log(level slog.Level, msg string, fields []any) {
+	s.l.Log(context.Background(), level, msg, fields...)
+}
@@ -0,0 +1,124 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger captures log messages by level
+type recordingLogger struct {
+	lines map[string][]string
+}
+
+func newRecordingLogger() *recordingLogger {
+	return &recordingLogger{lines: map[string][]string{}}
+}
+
+func (r *recordingLogger) Debug(msg string, fields ...any) {
+	r.lines["debug"] = append(r.lines["debug"], msg)
+}
+func (r *recordingLogger) Info(msg string, fields ...any) {
+	r.lines["info"] = append(r.lines["info"], msg)
+}
+func (r *recordingLogger) Warn(msg string, fields ...any) {
+	r.lines["warn"] = append(r.lines["warn"], msg)
+}
+func (r *recordingLogger) Error(msg string, fields ...any) {
+	r.lines["error"] = append(r.lines["error"], msg)
+}
+
+func TestClient_Logger(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockStatusCode int
+		wantLevels     []string
+	}{
+		{
+			name:           "Successful call",
+			mockStatusCode: http.StatusCreated,
+			wantLevels:     []string{"debug", "info"},
+		},
+		{
+			name:           "Failed call",
+			mockStatusCode: http.StatusBadRequest,
+			wantLevels:     []string{"debug", "error"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := newRecordingLogger()
+			mockClient := &MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: tt.mockStatusCode,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+					}, nil
+				},
+			}
+
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithLogger(logger))
+			client.WithHTTPClient(mockClient)
+
+			client.Call(context.Background())
+
+			for _, level := range tt.wantLevels {
+				assert.Len(t, logger.lines[level], 1, level)
+			}
+			assert.Len(t, logger.lines, len(tt.wantLevels))
+		})
+	}
+}
+
+func TestNewStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := ultravox.NewStdLogger(log.New(&buf, "", 0))
+
+	logger.Info("received response", "status", 200, "url", "https://example.com")
+	assert.Equal(t, "[INFO] received response status=200 url=https://example.com\n", buf.String())
+}
+
+func TestNewSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	handler := slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	logger := ultravox.NewSlogLogger(slog.New(handler))
+
+	logger.Warn("retrying request", "attempt", 2)
+	require.NotEmpty(t, buf.String())
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), `msg="retrying request"`)
+	assert.Contains(t, buf.String(), "attempt=2")
+}
+
+func TestClient_LoggerInsecureDataConnection(t *testing.T) {
+	for _, websocketURL := range []string{"ws://localhost:8080/data", "wss://example.com/data"} {
+		logger := newRecordingLogger()
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithLogger(logger))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+				}, nil
+			},
+		})
+
+		_, err := client.Call(context.Background(), ultravox.WithCallDataConnection(ultravox.NewDataConnectionConfig(websocketURL, 8000)))
+		require.NoError(t, err)
+		if strings.HasPrefix(websocketURL, "ws://") {
+			assert.Equal(t, []string{"data connection uses unencrypted ws://"}, logger.lines["warn"])
+		} else {
+			assert.Empty(t, logger.lines["warn"])
+		}
+	}
+}
@@ -1,6 +1,14 @@
 package ultravox
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
 
 // MessageRole constants
 const (
@@ -25,7 +33,33 @@ type Message struct {
 	Timespan              *InCallTimespan  `json:"timespan,omitempty" yaml:"timespan,omitempty"`
 }
 
-// TimedMessage represents a message that should be delivered after a specific duration
+// IsFromAgent reports whether the message was spoken by the agent
+func (m *Message) IsFromAgent() bool {
+	return m.Role == string(MessageRoleAgent)
+}
+
+// IsFromUser reports whether the message was spoken by the user
+func (m *Message) IsFromUser() bool {
+	return m.Role == string(MessageRoleUser)
+}
+
+// IsTool reports whether the message is a tool call or a tool result
+func (m *Message) IsTool() bool {
+	return m.Role == string(MessageRoleToolCall) || m.Role == string(MessageRoleToolResult)
+}
+
+// Duration returns how long the message took within the call, or
+// ErrNoTimespan if the message has no timespan
+func (m *Message) Duration() (time.Duration, error) {
+	if m.Timespan == nil {
+		return 0, ErrNoTimespan
+	}
+	return time.Duration(m.Timespan.End - m.Timespan.Start), nil
+}
+
+// TimedMessage represents a message that should be delivered after a specific
+// duration. In inactivityMessages each duration is counted from the previous
+// message, or from when the user fell silent for the first one.
 type TimedMessage struct {
 	Duration    UltravoxDuration `json:"duration" yaml:"duration"`
 	Message     string           `json:"message" yaml:"message"`
@@ -58,6 +92,18 @@ type FallbackAgentGreeting struct {
 	Prompt string           `json:"prompt,omitempty" yaml:"prompt,omitempty"`
 }
 
+// clone returns a deep copy of the first speaker settings
+func (s *FirstSpeakerSettings) clone() *FirstSpeakerSettings {
+	if s == nil {
+		return nil
+	}
+	c := FirstSpeakerSettings{Agent: clonePtr(s.Agent)}
+	if s.User != nil {
+		c.User = &UserGreeting{Fallback: clonePtr(s.User.Fallback)}
+	}
+	return &c
+}
+
 // VadSettings contains voice activity detection settings
 type VadSettings struct {
 	TurnEndpointDelay           UltravoxDuration `json:"turnEndpointDelay,omitempty" yaml:"turnEndpointDelay,omitempty"`
@@ -66,7 +112,27 @@ type VadSettings struct {
 	FrameActivationThreshold    float64          `json:"frameActivationThreshold,omitempty" yaml:"frameActivationThreshold,omitempty"`
 }
 
-// CallMedium defines the medium used for the call
+// Validate checks that the VAD settings are within the ranges accepted by the API
+func (v *VadSettings) Validate() error {
+	var errs []error
+	if v.TurnEndpointDelay < 0 {
+		errs = append(errs, fmt.Errorf("turnEndpointDelay must not be negative, got %s", v.TurnEndpointDelay))
+	}
+	if v.MinimumTurnDuration < 0 {
+		errs = append(errs, fmt.Errorf("minimumTurnDuration must not be negative, got %s", v.MinimumTurnDuration))
+	}
+	if v.MinimumInterruptionDuration < 0 {
+		errs = append(errs, fmt.Errorf("minimumInterruptionDuration must not be negative, got %s", v.MinimumInterruptionDuration))
+	}
+	if v.FrameActivationThreshold < 0 || v.FrameActivationThreshold > 1 {
+		errs = append(errs, fmt.Errorf("frameActivationThreshold must be between 0 and 1, got %g", v.FrameActivationThreshold))
+	}
+	return errors.Join(errs...)
+}
+
+// CallMedium defines the medium used for the call. Every medium carries the
+// call's audio; to receive call events without holding a connection open,
+// register an account webhook with CreateWebhook instead.
 type CallMedium struct {
 	WebRTC          *WebRTCMedium    `json:"webRtc,omitempty" yaml:"webRtc,omitempty"`
 	Twilio          *TwilioMedium    `json:"twilio,omitempty" yaml:"twilio,omitempty"`
@@ -77,19 +143,127 @@ type CallMedium struct {
 	SIP             *SIPMedium       `json:"sip,omitempty" yaml:"sip,omitempty"`
 }
 
-// WebRTCMedium defines WebRTC-specific configuration
+// Medium types returned by CallMedium.ActiveType, matching the JSON keys of CallMedium
+const (
+	MediumTypeWebRTC          = "webRtc"
+	MediumTypeTwilio          = "twilio"
+	MediumTypeServerWebSocket = "serverWebSocket"
+	MediumTypeTelnyx          = "telnyx"
+	MediumTypePlivo           = "plivo"
+	MediumTypeExotel          = "exotel"
+	MediumTypeSIP             = "sip"
+)
+
+// activeTypes returns the types of the transports that are set, in field order
+func (m *CallMedium) activeTypes() []string {
+	var types []string
+	for _, transport := range []struct {
+		name string
+		set  bool
+	}{
+		{MediumTypeWebRTC, m.WebRTC != nil},
+		{MediumTypeTwilio, m.Twilio != nil},
+		{MediumTypeServerWebSocket, m.ServerWebSocket != nil},
+		{MediumTypeTelnyx, m.Telnyx != nil},
+		{MediumTypePlivo, m.Plivo != nil},
+		{MediumTypeExotel, m.Exotel != nil},
+		{MediumTypeSIP, m.SIP != nil},
+	} {
+		if transport.set {
+			types = append(types, transport.name)
+		}
+	}
+	return types
+}
+
+// ActiveType returns the MediumType constant of the transport that is set, or
+// an empty string unless exactly one is set
+func (m *CallMedium) ActiveType() string {
+	if m == nil {
+		return ""
+	}
+	if types := m.activeTypes(); len(types) == 1 {
+		return types[0]
+	}
+	return ""
+}
+
+// Validate checks that exactly one transport is set and that its settings are usable
+func (m *CallMedium) Validate() error {
+	switch types := m.activeTypes(); len(types) {
+	case 0:
+		return errors.New("a transport is required")
+	case 1:
+	default:
+		return fmt.Errorf("only one transport may be set, got %d: %s", len(types), strings.Join(types, ", "))
+	}
+
+	if m.ServerWebSocket != nil {
+		if err := m.ServerWebSocket.Validate(); err != nil {
+			return fmt.Errorf("serverWebSocket: %w", err)
+		}
+	}
+	if m.SIP != nil && m.SIP.Outgoing != nil {
+		if err := m.SIP.Outgoing.Validate(); err != nil {
+			return fmt.Errorf("sip.outgoing: %w", err)
+		}
+	}
+	return nil
+}
+
+// clone returns a deep copy of the medium
+func (m *CallMedium) clone() *CallMedium {
+	if m == nil {
+		return nil
+	}
+	c := *m
+	c.WebRTC = clonePtr(m.WebRTC)
+	c.Twilio = clonePtr(m.Twilio)
+	c.ServerWebSocket = clonePtr(m.ServerWebSocket)
+	c.Telnyx = clonePtr(m.Telnyx)
+	c.Plivo = clonePtr(m.Plivo)
+	c.Exotel = clonePtr(m.Exotel)
+	if m.SIP != nil {
+		c.SIP = &SIPMedium{Incoming: clonePtr(m.SIP.Incoming), Outgoing: clonePtr(m.SIP.Outgoing)}
+		if c.SIP.Outgoing != nil {
+			c.SIP.Outgoing.Headers = maps.Clone(m.SIP.Outgoing.Headers)
+		}
+	}
+	return &c
+}
+
+// WebRTCMedium defines WebRTC-specific configuration. It has no fields: the
+// API takes no ICE or TURN settings, because Ultravox's WebRTC infrastructure
+// handles NAT traversal for the client that joins the call.
 type WebRTCMedium struct{}
 
 // TwilioMedium defines Twilio-specific configuration
 type TwilioMedium struct{}
 
-// WebSocketMedium defines WebSocket-specific connection parameters
+// WebSocketMedium defines WebSocket-specific connection parameters. Audio on
+// the socket is always 16-bit little-endian mono PCM, so only the sample rates
+// are configurable.
 type WebSocketMedium struct {
 	InputSampleRate    int `json:"inputSampleRate" yaml:"inputSampleRate"`
 	OutputSampleRate   int `json:"outputSampleRate,omitempty" yaml:"outputSampleRate,omitempty"`
 	ClientBufferSizeMs int `json:"clientBufferSizeMs,omitempty" yaml:"clientBufferSizeMs,omitempty"`
 }
 
+// Validate checks the sample rates. An unset output sample rate defaults to
+// the input rate.
+func (m *WebSocketMedium) Validate() error {
+	var errs []error
+
+	if m.InputSampleRate <= 0 {
+		errs = append(errs, fmt.Errorf("inputSampleRate must be positive, got %d", m.InputSampleRate))
+	}
+	if m.OutputSampleRate < 0 {
+		errs = append(errs, fmt.Errorf("outputSampleRate must not be negative, got %d", m.OutputSampleRate))
+	}
+
+	return errors.Join(errs...)
+}
+
 // TelnyxMedium defines Telnyx-specific configuration
 type TelnyxMedium struct{}
 
@@ -110,10 +284,47 @@ type SIPIncoming struct{}
 
 // SIPOutgoing defines outgoing SIP call configuration
 type SIPOutgoing struct {
-	To       string `json:"to" yaml:"to"`
-	From     string `json:"from" yaml:"from"`
-	Username string `json:"username,omitempty" yaml:"username,omitempty"`
-	Password string `json:"password,omitempty" yaml:"password,omitempty"`
+	To       string            `json:"to" yaml:"to"`
+	From     string            `json:"from" yaml:"from"`
+	Username string            `json:"username,omitempty" yaml:"username,omitempty"`
+	Password string            `json:"password,omitempty" yaml:"password,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+}
+
+// WithOutgoingHeaders adds headers, such as X- routing headers, to the INVITE
+// of an outgoing SIP call, keeping any headers already set
+func (m *SIPMedium) WithOutgoingHeaders(headers map[string]string) *SIPMedium {
+	if m.Outgoing == nil {
+		m.Outgoing = &SIPOutgoing{}
+	}
+	m.Outgoing.addHeaders(headers)
+	return m
+}
+
+// addHeaders merges headers into a copy of the existing headers, so that maps
+// shared with other mediums are left untouched
+func (o *SIPOutgoing) addHeaders(headers map[string]string) {
+	merged := maps.Clone(o.Headers)
+	if merged == nil {
+		merged = make(map[string]string, len(headers))
+	}
+	maps.Copy(merged, headers)
+	o.Headers = merged
+}
+
+// Validate checks that To is a SIP or telephone URI and that credentials are complete
+func (o *SIPOutgoing) Validate() error {
+	var errs []error
+	switch {
+	case o.To == "":
+		errs = append(errs, errors.New("to is required"))
+	case !strings.HasPrefix(o.To, "sip:") && !strings.HasPrefix(o.To, "sips:") && !strings.HasPrefix(o.To, "tel:+"):
+		errs = append(errs, fmt.Errorf("to must be a sip:, sips: or tel:+ URI, got %q", o.To))
+	}
+	if o.Username != "" && o.Password == "" {
+		errs = append(errs, errors.New("password is required when username is set"))
+	}
+	return errors.Join(errs...)
 }
 
 // DataConnectionConfig contains settings for data connections
@@ -122,6 +333,51 @@ type DataConnectionConfig struct {
 	AudioConfig  *DataConnectionAudioConfig `json:"audioConfig,omitempty" yaml:"audioConfig,omitempty"`
 }
 
+// Validate checks that WebsocketURL is a ws:// or wss:// URL and that the
+// audio sample rate, when set, is a standard rate. Plain ws:// is accepted,
+// but Call logs a warning for it since call audio would travel unencrypted.
+func (d *DataConnectionConfig) Validate() error {
+	var errs []error
+
+	u, err := url.Parse(d.WebsocketURL)
+	switch {
+	case d.WebsocketURL == "":
+		errs = append(errs, errors.New("websocketUrl is required"))
+	case err != nil:
+		errs = append(errs, fmt.Errorf("websocketUrl is not a valid URL: %w", err))
+	case u.Scheme != "wss" && u.Scheme != "ws":
+		errs = append(errs, fmt.Errorf("websocketUrl must use wss:// or ws://, got %q", d.WebsocketURL))
+	case u.Host == "":
+		errs = append(errs, fmt.Errorf("websocketUrl has no host: %q", d.WebsocketURL))
+	}
+
+	if d.AudioConfig != nil && d.AudioConfig.SampleRate != 0 {
+		switch d.AudioConfig.SampleRate {
+		case 8000, 16000, 24000, 44100, 48000:
+		default:
+			errs = append(errs, fmt.Errorf("audioConfig.sampleRate must be 8000, 16000, 24000, 44100 or 48000, got %d", d.AudioConfig.SampleRate))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// insecure reports whether the data connection is sent over unencrypted ws://
+func (d *DataConnectionConfig) insecure() bool {
+	u, err := url.Parse(d.WebsocketURL)
+	return err == nil && u.Scheme == "ws"
+}
+
+// clone returns a deep copy of the data connection settings
+func (d *DataConnectionConfig) clone() *DataConnectionConfig {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	c.AudioConfig = clonePtr(d.AudioConfig)
+	return &c
+}
+
 // DataConnectionAudioConfig defines audio settings for data connections
 type DataConnectionAudioConfig struct {
 	SampleRate  int    `json:"sampleRate,omitempty" yaml:"sampleRate,omitempty"`
@@ -163,6 +419,35 @@ func NewVadSettings() *VadSettings {
 	}
 }
 
+// NewHighSensitivityVadSettings returns VAD settings for quiet environments,
+// such as studio use: speech is detected readily and turns end quickly
+func NewHighSensitivityVadSettings() *VadSettings {
+	v := NewVadSettings()
+	v.FrameActivationThreshold = 0.3
+	v.TurnEndpointDelay = UltravoxDuration(200 * time.Millisecond)
+	return v
+}
+
+// NewLowSensitivityVadSettings returns VAD settings that need louder speech
+// and a longer pause before the agent takes its turn
+func NewLowSensitivityVadSettings() *VadSettings {
+	v := NewVadSettings()
+	v.FrameActivationThreshold = 0.7
+	v.TurnEndpointDelay = UltravoxDuration(500 * time.Millisecond)
+	return v
+}
+
+// NewNoiseRobustVadSettings returns VAD settings for noisy environments, such
+// as call centres, where short bursts of background noise must not start a
+// turn or interrupt the agent
+func NewNoiseRobustVadSettings() *VadSettings {
+	v := NewVadSettings()
+	v.FrameActivationThreshold = 0.6
+	v.MinimumTurnDuration = UltravoxDuration(300 * time.Millisecond)
+	v.MinimumInterruptionDuration = UltravoxDuration(200 * time.Millisecond)
+	return v
+}
+
 // NewTimedMessage creates a new timed message
 func NewTimedMessage(duration time.Duration, message string, endBehavior EndBehaviorType) TimedMessage {
 	return TimedMessage{
@@ -172,6 +457,29 @@ func NewTimedMessage(duration time.Duration, message string, endBehavior EndBeha
 	}
 }
 
+// TimedMessageSequence builds inactivity messages in the order they are
+// delivered, each after its own delay
+type TimedMessageSequence struct {
+	messages []TimedMessage
+}
+
+// NewTimedMessageSequence returns an empty sequence
+func NewTimedMessageSequence() *TimedMessageSequence {
+	return &TimedMessageSequence{}
+}
+
+// Add appends a message delivered delay after the previous one, or after the
+// user falls silent for the first message
+func (s *TimedMessageSequence) Add(delay time.Duration, message string, endBehavior EndBehaviorType) *TimedMessageSequence {
+	s.messages = append(s.messages, NewTimedMessage(delay, message, endBehavior))
+	return s
+}
+
+// Build returns the messages in order, ready for WithCallInactivityMessages
+func (s *TimedMessageSequence) Build() []TimedMessage {
+	return slices.Clone(s.messages)
+}
+
 // NewDataConnectionConfig creates a new data connection configuration
 func NewDataConnectionConfig(websocketURL string, sampleRate int) *DataConnectionConfig {
 	return &DataConnectionConfig{
@@ -0,0 +1,298 @@
+package ultravox_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketMedium_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		medium  ultravox.WebSocketMedium
+		wantErr string
+	}{
+		{
+			name:   "PCM",
+			medium: ultravox.WebSocketMedium{InputSampleRate: 16000, OutputSampleRate: 24000},
+		},
+		{
+			name:    "Negative output rate",
+			medium:  ultravox.WebSocketMedium{InputSampleRate: 8000, OutputSampleRate: -1},
+			wantErr: "outputSampleRate must not be negative",
+		},
+		{
+			name:    "Missing input rate",
+			medium:  ultravox.WebSocketMedium{},
+			wantErr: "inputSampleRate must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.medium.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithCallSIPOutgoingWithHeaders(t *testing.T) {
+	t.Run("With headers", func(t *testing.T) {
+		headers := map[string]string{
+			"X-Account":           "acct-42",
+			"P-Asserted-Identity": "<sip:+15550100@trunk.example.com>",
+		}
+
+		var request ultravox.CallRequest
+		ultravox.WithCallSIPOutgoingWithHeaders("sip:+15550123@trunk.example.com", "sip:agent@example.com", "user", "secret", headers)(&request)
+		headers["X-Account"] = "changed"
+
+		data, err := json.Marshal(request.Medium)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sip": {"outgoing": {
+			"to": "sip:+15550123@trunk.example.com",
+			"from": "sip:agent@example.com",
+			"username": "user",
+			"password": "secret",
+			"headers": {
+				"X-Account": "acct-42",
+				"P-Asserted-Identity": "<sip:+15550100@trunk.example.com>"
+			}
+		}}}`, string(data))
+	})
+
+	t.Run("Without headers", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallSIPOutgoing("sip:+15550123@trunk.example.com", "sip:agent@example.com", "", "")(&request)
+
+		data, err := json.Marshal(request.Medium)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sip": {"outgoing": {
+			"to": "sip:+15550123@trunk.example.com",
+			"from": "sip:agent@example.com"
+		}}}`, string(data))
+	})
+}
+
+func TestTimedMessageSequence(t *testing.T) {
+	messages := ultravox.NewTimedMessageSequence().
+		Add(5*time.Second, "Are you still there?", ultravox.EndBehaviorDefault).
+		Add(10*time.Second, "I'll hang up soon.", ultravox.EndBehaviorDefault).
+		Add(20*time.Second, "Goodbye.", ultravox.EndBehaviorHangUpSoft).
+		Build()
+
+	assert.Equal(t, []ultravox.TimedMessage{
+		ultravox.NewTimedMessage(5*time.Second, "Are you still there?", ultravox.EndBehaviorDefault),
+		ultravox.NewTimedMessage(10*time.Second, "I'll hang up soon.", ultravox.EndBehaviorDefault),
+		ultravox.NewTimedMessage(20*time.Second, "Goodbye.", ultravox.EndBehaviorHangUpSoft),
+	}, messages)
+
+	assert.Empty(t, ultravox.NewTimedMessageSequence().Build())
+}
+
+func TestWithCallSIPOutgoingHeaders(t *testing.T) {
+	var request ultravox.CallRequest
+	ultravox.WithCallSIPOutgoingWithHeaders("sip:+15550123@trunk.example.com", "sip:agent@example.com", "user", "secret",
+		map[string]string{"X-Account": "acct-42"})(&request)
+	medium := request.Medium
+	ultravox.WithCallSIPOutgoingHeaders(map[string]string{"X-Route": "eu-west"})(&request)
+	require.NoError(t, request.Validate())
+
+	assert.Equal(t, map[string]string{"X-Account": "acct-42", "X-Route": "eu-west"}, request.Medium.SIP.Outgoing.Headers)
+	assert.Equal(t, map[string]string{"X-Account": "acct-42"}, medium.SIP.Outgoing.Headers, "the earlier medium is not modified")
+
+	t.Run("Medium method", func(t *testing.T) {
+		sip := (&ultravox.SIPMedium{}).WithOutgoingHeaders(map[string]string{"X-Route": "eu-west"})
+		assert.Equal(t, map[string]string{"X-Route": "eu-west"}, sip.Outgoing.Headers)
+	})
+
+	t.Run("No outgoing SIP medium", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		_, err := client.Call(context.Background(),
+			ultravox.WithCallSIPIncoming(),
+			ultravox.WithCallSIPOutgoingHeaders(map[string]string{"X-Route": "eu-west"}),
+		)
+		assert.ErrorContains(t, err, "SIP headers require an outgoing SIP medium")
+	})
+}
+
+func TestSIPOutgoing_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		outgoing ultravox.SIPOutgoing
+		wantErr  string
+	}{
+		{name: "SIP URI", outgoing: ultravox.SIPOutgoing{To: "sip:+15550123@trunk.example.com"}},
+		{name: "Secure SIP URI", outgoing: ultravox.SIPOutgoing{To: "sips:agent@example.com"}},
+		{name: "Telephone URI", outgoing: ultravox.SIPOutgoing{To: "tel:+15550123", Username: "user", Password: "secret"}},
+		{name: "Missing to", outgoing: ultravox.SIPOutgoing{}, wantErr: "to is required"},
+		{name: "Bare number", outgoing: ultravox.SIPOutgoing{To: "+15550123"}, wantErr: `to must be a sip:, sips: or tel:+ URI, got "+15550123"`},
+		{name: "Local telephone number", outgoing: ultravox.SIPOutgoing{To: "tel:5550123"}, wantErr: "to must be"},
+		{name: "Username without password", outgoing: ultravox.SIPOutgoing{To: "sip:agent@example.com", Username: "user"}, wantErr: "password is required when username is set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.outgoing.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			medium := ultravox.CallMedium{SIP: &ultravox.SIPMedium{Outgoing: &tt.outgoing}}
+			assert.ErrorContains(t, medium.Validate(), "sip.outgoing: "+tt.wantErr)
+		})
+	}
+}
+
+func TestDataConnectionConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *ultravox.DataConnectionConfig
+		wantErr string
+	}{
+		{name: "Secure", config: ultravox.NewDataConnectionConfig("wss://example.com/data", 16000)},
+		{name: "Plain", config: ultravox.NewDataConnectionConfig("ws://localhost:8080/data", 0)},
+		{name: "Missing URL", config: &ultravox.DataConnectionConfig{}, wantErr: "websocketUrl is required"},
+		{name: "Unparsable URL", config: &ultravox.DataConnectionConfig{WebsocketURL: "wss://exa mple.com"}, wantErr: "websocketUrl is not a valid URL"},
+		{name: "HTTP URL", config: &ultravox.DataConnectionConfig{WebsocketURL: "https://example.com/data"}, wantErr: "websocketUrl must use wss:// or ws://"},
+		{name: "No host", config: &ultravox.DataConnectionConfig{WebsocketURL: "wss:///data"}, wantErr: "websocketUrl has no host"},
+		{name: "Unusual sample rate", config: ultravox.NewDataConnectionConfig("wss://example.com/data", 22050), wantErr: "audioConfig.sampleRate must be 8000, 16000, 24000, 44100 or 48000, got 22050"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			var request ultravox.CallRequest
+			ultravox.WithCallDataConnection(tt.config)(&request)
+			assert.ErrorContains(t, request.Validate(), "dataConnection: "+tt.wantErr)
+		})
+	}
+}
+
+func TestCallMedium_ActiveType(t *testing.T) {
+	tests := []struct {
+		name   string
+		option ultravox.CallOption
+		want   string
+	}{
+		{"WebRTC", ultravox.WithCallWebRTCMedium(), ultravox.MediumTypeWebRTC},
+		{"Server WebSocket", ultravox.WithCallWebSocketMedium(16000, 16000), ultravox.MediumTypeServerWebSocket},
+		{"SIP", ultravox.WithCallSIPIncoming(), ultravox.MediumTypeSIP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request ultravox.CallRequest
+			tt.option(&request)
+			assert.Equal(t, tt.want, request.Medium.ActiveType())
+		})
+	}
+
+	t.Run("None or several", func(t *testing.T) {
+		var medium *ultravox.CallMedium
+		assert.Empty(t, medium.ActiveType())
+		assert.Empty(t, (&ultravox.CallMedium{}).ActiveType())
+		assert.Empty(t, (&ultravox.CallMedium{WebRTC: &ultravox.WebRTCMedium{}, Twilio: &ultravox.TwilioMedium{}}).ActiveType())
+	})
+}
+
+func TestVadSettingsPresets(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *ultravox.VadSettings
+		want     ultravox.VadSettings
+	}{
+		{
+			name:     "High sensitivity",
+			settings: ultravox.NewHighSensitivityVadSettings(),
+			want: ultravox.VadSettings{
+				TurnEndpointDelay:           ultravox.UltravoxDuration(200 * time.Millisecond),
+				MinimumInterruptionDuration: ultravox.UltravoxDuration(90 * time.Millisecond),
+				FrameActivationThreshold:    0.3,
+			},
+		},
+		{
+			name:     "Low sensitivity",
+			settings: ultravox.NewLowSensitivityVadSettings(),
+			want: ultravox.VadSettings{
+				TurnEndpointDelay:           ultravox.UltravoxDuration(500 * time.Millisecond),
+				MinimumInterruptionDuration: ultravox.UltravoxDuration(90 * time.Millisecond),
+				FrameActivationThreshold:    0.7,
+			},
+		},
+		{
+			name:     "Noise robust",
+			settings: ultravox.NewNoiseRobustVadSettings(),
+			want: ultravox.VadSettings{
+				TurnEndpointDelay:           ultravox.UltravoxDuration(384 * time.Millisecond),
+				MinimumTurnDuration:         ultravox.UltravoxDuration(300 * time.Millisecond),
+				MinimumInterruptionDuration: ultravox.UltravoxDuration(200 * time.Millisecond),
+				FrameActivationThreshold:    0.6,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, *tt.settings)
+			assert.NoError(t, tt.settings.Validate())
+		})
+	}
+
+	t.Run("Presets are independent", func(t *testing.T) {
+		a := ultravox.NewNoiseRobustVadSettings()
+		a.FrameActivationThreshold = 0.9
+		assert.Equal(t, 0.6, ultravox.NewNoiseRobustVadSettings().FrameActivationThreshold)
+	})
+}
+
+func TestMessage_Roles(t *testing.T) {
+	tests := []struct {
+		role                      ultravox.MessageRole
+		fromAgent, fromUser, tool bool
+	}{
+		{ultravox.MessageRoleAgent, true, false, false},
+		{ultravox.MessageRoleUser, false, true, false},
+		{ultravox.MessageRoleToolCall, false, false, true},
+		{ultravox.MessageRoleToolResult, false, false, true},
+		{ultravox.MessageRoleUnspecified, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			m := ultravox.Message{Role: string(tt.role)}
+			assert.Equal(t, tt.fromAgent, m.IsFromAgent())
+			assert.Equal(t, tt.fromUser, m.IsFromUser())
+			assert.Equal(t, tt.tool, m.IsTool())
+		})
+	}
+}
+
+func TestMessage_Duration(t *testing.T) {
+	var m ultravox.Message
+	require.NoError(t, json.Unmarshal([]byte(`{"role":"MESSAGE_ROLE_USER","timespan":{"start":"1.5s","end":"4s"}}`), &m))
+
+	d, err := m.Duration()
+	require.NoError(t, err)
+	assert.Equal(t, 2500*time.Millisecond, d)
+
+	_, err = (&ultravox.Message{}).Duration()
+	assert.ErrorIs(t, err, ultravox.ErrNoTimespan)
+}
@@ -0,0 +1,111 @@
+package ultravox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// retryAttemptKey marks the context of a retried request with its attempt number
+type retryAttemptKey struct{}
+
+// WithMetrics records Prometheus metrics for API requests on reg, or on the
+// default registerer when reg is nil. Collectors already registered by another
+// client are reused, so several clients can share one registry.
+func WithMetrics(reg prometheus.Registerer) Option {
+	return func(c *Config) {
+		if reg == nil {
+			reg = prometheus.DefaultRegisterer
+		}
+		c.MetricsRegisterer = reg
+	}
+}
+
+// clientMetrics holds the collectors updated by metricsHTTPClient
+type clientMetrics struct {
+	callCreateDuration prometheus.Histogram
+	apiErrors          *prometheus.CounterVec
+	retryAttempts      prometheus.Counter
+}
+
+// newClientMetrics registers the client's collectors on reg, reusing any that exist
+func newClientMetrics(reg prometheus.Registerer) *clientMetrics {
+	return &clientMetrics{
+		callCreateDuration: register(reg, prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "ultravox_call_create_duration_seconds",
+			Help:    "Latency of call creation requests to the Ultravox API.",
+			Buckets: prometheus.DefBuckets,
+		})),
+		apiErrors: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "ultravox_api_errors_total",
+			Help: "Failed Ultravox API requests by HTTP status code, or \"error\" when no response was received.",
+		}, []string{"status_code"})),
+		retryAttempts: register(reg, prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "ultravox_retry_attempts_total",
+			Help: "Ultravox API requests retried after a failed attempt.",
+		})),
+	}
+}
+
+// register adds c to reg, returning the existing collector instead if an identical one is already registered
+func register[T prometheus.Collector](reg prometheus.Registerer, c T) T {
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+	}
+	return c
+}
+
+// metricsHTTPClient wraps an HTTPClient, recording metrics for every request it sends
+type metricsHTTPClient struct {
+	next    HTTPClient
+	metrics *clientMetrics
+}
+
+// Do implements the HTTPClient interface
+func (m *metricsHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	if _, retried := req.Context().Value(retryAttemptKey{}).(int); retried {
+		m.metrics.retryAttempts.Inc()
+	}
+
+	start := time.Now()
+	resp, err := m.next.Do(req)
+	if isCallCreation(req) {
+		m.metrics.callCreateDuration.Observe(time.Since(start).Seconds())
+	}
+
+	switch {
+	case err != nil:
+		m.metrics.apiErrors.WithLabelValues("error").Inc()
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		m.metrics.apiErrors.WithLabelValues(strconv.Itoa(resp.StatusCode)).Inc()
+	}
+	return resp, err
+}
+
+// isCallCreation reports whether req creates a call, directly or through an agent
+func isCallCreation(req *http.Request) bool {
+	return req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/calls")
+}
+
+// withRetryAttempt marks ctx as belonging to the given retry attempt
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+// instrument wraps httpClient with metrics collection when metrics are enabled
+func (c *Client) instrument(httpClient HTTPClient) HTTPClient {
+	if c.metrics == nil {
+		return httpClient
+	}
+	return &metricsHTTPClient{next: httpClient, metrics: c.metrics}
+}
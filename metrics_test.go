@@ -0,0 +1,83 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// gatherMetrics collects the metric families in reg, keyed by name
+func gatherMetrics(t *testing.T, reg *prometheus.Registry) map[string]*dto.MetricFamily {
+	t.Helper()
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	byName := make(map[string]*dto.MetricFamily, len(families))
+	for _, f := range families {
+		byName[f.GetName()] = f
+	}
+	return byName
+}
+
+func TestClient_Metrics(t *testing.T) {
+	statuses := []int{http.StatusServiceUnavailable, http.StatusCreated, http.StatusNotFound}
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			status := statuses[0]
+			statuses = statuses[1:]
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	}
+
+	reg := prometheus.NewRegistry()
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithMetrics(reg),
+		ultravox.WithRetryPolicy(2, time.Millisecond, time.Millisecond),
+	)
+	client.WithHTTPClient(mockClient)
+
+	_, err := client.Call(context.Background())
+	require.NoError(t, err)
+
+	_, err = client.GetCall(context.Background(), "missing")
+	require.Error(t, err)
+
+	metrics := gatherMetrics(t, reg)
+
+	require.Contains(t, metrics, "ultravox_call_create_duration_seconds")
+	histogram := metrics["ultravox_call_create_duration_seconds"].GetMetric()[0].GetHistogram()
+	assert.Equal(t, uint64(2), histogram.GetSampleCount())
+
+	require.Contains(t, metrics, "ultravox_retry_attempts_total")
+	assert.Equal(t, 1.0, metrics["ultravox_retry_attempts_total"].GetMetric()[0].GetCounter().GetValue())
+
+	require.Contains(t, metrics, "ultravox_api_errors_total")
+	errorsByStatus := map[string]float64{}
+	for _, m := range metrics["ultravox_api_errors_total"].GetMetric() {
+		errorsByStatus[m.GetLabel()[0].GetValue()] = m.GetCounter().GetValue()
+	}
+	assert.Equal(t, map[string]float64{"503": 1, "404": 1}, errorsByStatus)
+}
+
+func TestClient_MetricsSharedRegistry(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	assert.NotPanics(t, func() {
+		ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithMetrics(reg))
+		ultravox.NewClient(ultravox.WithAPIKey("test-api-key"), ultravox.WithMetrics(reg))
+	})
+}
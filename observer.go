@@ -0,0 +1,28 @@
+package ultravox
+
+import "time"
+
+// CallMetrics describes the outcome of one Client.Call
+type CallMetrics struct {
+	// Duration is the time Call took, including validation, rate limiting and retries
+	Duration time.Duration
+
+	// StatusCode is the HTTP status of the final response, or zero when the
+	// call failed before a response was received
+	StatusCode int
+
+	// CallID is the ID of the created call, empty on failure
+	CallID string
+
+	// Err is the error returned by Call, if any
+	Err error
+}
+
+// WithObserver calls observer after every call creation, whether it succeeded
+// or failed. It runs synchronously on the goroutine that called Call, so it
+// should return quickly.
+func WithObserver(observer func(CallMetrics)) Option {
+	return func(c *Config) {
+		c.Observer = observer
+	}
+}
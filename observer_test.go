@@ -0,0 +1,75 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithObserver(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		opts       []ultravox.CallOption
+		wantStatus int
+		wantCallID string
+		wantErr    bool
+	}{
+		{
+			name:       "Success",
+			status:     http.StatusCreated,
+			body:       `{"callId": "call-123", "joinUrl": "wss://example.com/join"}`,
+			wantStatus: http.StatusCreated,
+			wantCallID: "call-123",
+		},
+		{
+			name:       "API error",
+			status:     http.StatusBadRequest,
+			body:       `{"detail": "bad voice"}`,
+			wantStatus: http.StatusBadRequest,
+			wantErr:    true,
+		},
+		{
+			name:    "Validation error",
+			opts:    []ultravox.CallOption{ultravox.WithCallTemperature(5)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var observed []ultravox.CallMetrics
+			client := ultravox.NewClient(
+				ultravox.WithAPIKey("test-api-key"),
+				ultravox.WithObserver(func(m ultravox.CallMetrics) {
+					observed = append(observed, m)
+				}),
+			)
+			client.WithHTTPClient(&MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: tt.status,
+						Body:       io.NopCloser(bytes.NewBufferString(tt.body)),
+					}, nil
+				},
+			})
+
+			_, err := client.Call(context.Background(), tt.opts...)
+
+			require.Len(t, observed, 1)
+			m := observed[0]
+			assert.Equal(t, tt.wantStatus, m.StatusCode)
+			assert.Equal(t, tt.wantCallID, m.CallID)
+			assert.Equal(t, err, m.Err)
+			assert.Equal(t, tt.wantErr, m.Err != nil)
+			assert.Positive(t, m.Duration)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PollOption configures when PollCallStatus stops polling
+type PollOption func(*pollConfig)
+
+// pollConfig holds the termination condition for PollCallStatus
+type pollConfig struct {
+	done func(*Call) bool
+}
+
+// WithPollUntilJoined makes PollCallStatus return once the call has been
+// joined. A call that ends without being joined also stops the poll; check
+// Call.Ended to tell the two apart.
+func WithPollUntilJoined() PollOption {
+	return func(p *pollConfig) {
+		p.done = func(call *Call) bool {
+			return call.Joined != "" || call.Ended != ""
+		}
+	}
+}
+
+// WithPollUntilEnded makes PollCallStatus return once the call has ended
+func WithPollUntilEnded() PollOption {
+	return func(p *pollConfig) {
+		p.done = func(call *Call) bool {
+			return call.Ended != ""
+		}
+	}
+}
+
+// PollCallStatus fetches the call every interval until it is joined, or until
+// it ends when WithPollUntilEnded is given, and returns its latest state. The
+// first fetch is made immediately. Errors from GetCall stop the poll, and
+// cancelling ctx interrupts it with ctx.Err().
+func (c *Client) PollCallStatus(ctx context.Context, callID string, interval time.Duration, opts ...PollOption) (*Call, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("poll interval must be positive, got %s", interval)
+	}
+
+	var config pollConfig
+	WithPollUntilJoined()(&config)
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		call, err := c.GetCall(ctx, callID)
+		if err != nil {
+			return nil, err
+		}
+		if config.done(call) {
+			return call, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
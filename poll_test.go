@@ -0,0 +1,92 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPollingClient returns a client whose GetCall responses walk through
+// states, repeating the last one, and a counter of requests made
+func newPollingClient(t *testing.T, states ...string) (*ultravox.Client, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123", req.URL.String())
+			n := int(requests.Add(1))
+			state := states[min(n, len(states))-1]
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(fmt.Sprintf(`{"callId": "call-123", %s}`, state))),
+			}, nil
+		},
+	})
+	return client, &requests
+}
+
+func TestClient_PollCallStatus(t *testing.T) {
+	const (
+		created = `"created": "2025-01-01T10:00:00Z"`
+		joined  = created + `, "joined": "2025-01-01T10:00:05Z"`
+		ended   = joined + `, "ended": "2025-01-01T10:02:00Z"`
+	)
+
+	t.Run("Until joined", func(t *testing.T) {
+		client, requests := newPollingClient(t, created, created, joined, ended)
+
+		call, err := client.PollCallStatus(context.Background(), "call-123", time.Millisecond)
+		require.NoError(t, err)
+		assert.Equal(t, "2025-01-01T10:00:05Z", call.Joined)
+		assert.Empty(t, call.Ended)
+		assert.EqualValues(t, 3, requests.Load())
+	})
+
+	t.Run("Until ended", func(t *testing.T) {
+		client, requests := newPollingClient(t, created, joined, joined, ended)
+
+		call, err := client.PollCallStatus(context.Background(), "call-123", time.Millisecond, ultravox.WithPollUntilEnded())
+		require.NoError(t, err)
+		assert.Equal(t, "2025-01-01T10:02:00Z", call.Ended)
+		assert.EqualValues(t, 4, requests.Load())
+	})
+
+	t.Run("Ended without joining", func(t *testing.T) {
+		client, _ := newPollingClient(t, created, created+`, "ended": "2025-01-01T10:00:30Z", "endReason": "unjoined"`)
+
+		call, err := client.PollCallStatus(context.Background(), "call-123", time.Millisecond, ultravox.WithPollUntilJoined())
+		require.NoError(t, err)
+		assert.Empty(t, call.Joined)
+		assert.Equal(t, "unjoined", call.EndReason)
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		client, _ := newPollingClient(t, created)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		call, err := client.PollCallStatus(ctx, "call-123", time.Millisecond)
+		assert.Nil(t, call)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Invalid interval", func(t *testing.T) {
+		client, requests := newPollingClient(t, created)
+
+		_, err := client.PollCallStatus(context.Background(), "call-123", 0)
+		assert.ErrorContains(t, err, "poll interval must be positive")
+		assert.Zero(t, requests.Load())
+	})
+}
@@ -0,0 +1,97 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// WithCallSystemPromptTemplate renders tmpl with text/template and uses the
+// result as the system prompt. When data is nil the call's TemplateContext is
+// used, so {{.UserFirstname}} works without extra setup. Rendering errors are
+// returned by Call before any request is made.
+func WithCallSystemPromptTemplate(tmpl string, data any) CallOption {
+	return func(r *CallRequest) {
+		prompt, err := renderPrompt("systemPrompt", tmpl, data, r)
+		if err != nil {
+			r.setOptionErr(err)
+			return
+		}
+		r.SystemPrompt = prompt
+	}
+}
+
+// WithCallSystemPromptFromFile is like WithCallSystemPromptTemplate, reading the template from path
+func WithCallSystemPromptFromFile(path string, data any) CallOption {
+	return func(r *CallRequest) {
+		tmpl, err := os.ReadFile(path)
+		if err != nil {
+			r.setOptionErr(fmt.Errorf("failed to read system prompt template: %w", err))
+			return
+		}
+
+		prompt, err := renderPrompt(path, string(tmpl), data, r)
+		if err != nil {
+			r.setOptionErr(err)
+			return
+		}
+		r.SystemPrompt = prompt
+	}
+}
+
+// WithCallDynamicSystemPrompt computes the system prompt when the call is
+// created, for prompts that depend on a database or another service. fn is
+// called by Call, or by CreateAgent and UpdateAgent for an agent's call
+// template, with the request's context once every other option has been
+// applied, and its result replaces any static system prompt. An error from fn
+// is returned before any request is made.
+func WithCallDynamicSystemPrompt(fn func(context.Context) (string, error)) CallOption {
+	return func(r *CallRequest) {
+		r.dynamicSystemPrompt = fn
+	}
+}
+
+// resolveDynamicSystemPrompt sets the system prompt from the function given to
+// WithCallDynamicSystemPrompt, if any
+func (r *CallRequest) resolveDynamicSystemPrompt(ctx context.Context) error {
+	if r.dynamicSystemPrompt == nil {
+		return nil
+	}
+	prompt, err := r.dynamicSystemPrompt(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build dynamic system prompt: %w", err)
+	}
+	r.SystemPrompt = prompt
+	return nil
+}
+
+// renderPrompt executes a prompt template, defaulting data to the request's TemplateContext
+func renderPrompt(name, tmpl string, data any, r *CallRequest) (string, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse system prompt template: %w", err)
+	}
+
+	if data == nil {
+		if r.TemplateContext != nil {
+			data = r.TemplateContext
+		} else {
+			data = TemplateContext{}
+		}
+	}
+
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render system prompt template: %w", err)
+	}
+	return b.String(), nil
+}
+
+// setOptionErr keeps the first option error so Call can report it
+func (r *CallRequest) setOptionErr(err error) {
+	if r.optionErr == nil {
+		r.optionErr = err
+	}
+}
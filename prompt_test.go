@@ -0,0 +1,137 @@
+package ultravox_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithCallSystemPromptTemplate(t *testing.T) {
+	type account struct {
+		Name    string
+		Balance float64
+	}
+
+	t.Run("Custom data", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallSystemPromptTemplate(
+			`Greet {{.Name}} and mention their balance of ${{printf "%.2f" .Balance}}.`,
+			account{Name: "Amina", Balance: 42.5},
+		)(&request)
+
+		assert.Equal(t, "Greet Amina and mention their balance of $42.50.", request.SystemPrompt)
+	})
+
+	t.Run("Template context by default", func(t *testing.T) {
+		request := ultravox.CallRequest{
+			TemplateContext: &ultravox.TemplateContext{UserFirstname: "Paul"},
+		}
+		ultravox.WithCallSystemPromptTemplate("You are speaking with {{.UserFirstname}}.", nil)(&request)
+
+		assert.Equal(t, "You are speaking with Paul.", request.SystemPrompt)
+	})
+
+	t.Run("From file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "prompt.tmpl")
+		require.NoError(t, os.WriteFile(path, []byte("Your appointment is at {{.Time}}."), 0o600))
+
+		var request ultravox.CallRequest
+		ultravox.WithCallSystemPromptFromFile(path, map[string]string{"Time": "3pm"})(&request)
+
+		assert.Equal(t, "Your appointment is at 3pm.", request.SystemPrompt)
+	})
+}
+
+func TestWithCallSystemPromptTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opt     ultravox.CallOption
+		wantErr string
+	}{
+		{
+			name:    "Parse error",
+			opt:     ultravox.WithCallSystemPromptTemplate("Hello {{.Name", nil),
+			wantErr: "failed to parse system prompt template",
+		},
+		{
+			name:    "Missing key",
+			opt:     ultravox.WithCallSystemPromptTemplate("Hello {{.Nickname}}", map[string]string{"Name": "Amina"}),
+			wantErr: "failed to render system prompt template",
+		},
+		{
+			name:    "Missing file",
+			opt:     ultravox.WithCallSystemPromptFromFile(filepath.Join(t.TempDir(), "missing.tmpl"), nil),
+			wantErr: "failed to read system prompt template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(&MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					t.Fatal("requests with template errors must not reach the API")
+					return nil, nil
+				},
+			})
+
+			call, err := client.Call(context.Background(), tt.opt)
+			assert.Nil(t, call)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWithCallDynamicSystemPrompt(t *testing.T) {
+	type ctxKey struct{}
+
+	var body map[string]interface{}
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	})
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "Amina")
+	_, err := client.Call(ctx,
+		ultravox.WithCallDynamicSystemPrompt(func(ctx context.Context) (string, error) {
+			return fmt.Sprintf("You are speaking with %s.", ctx.Value(ctxKey{})), nil
+		}),
+		ultravox.WithCallSystemPrompt("static prompt"),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "You are speaking with Amina.", body["systemPrompt"], "the dynamic prompt is applied after static options")
+
+	t.Run("Error", func(t *testing.T) {
+		errLookup := errors.New("database unavailable")
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				t.Fatal("no request should be sent when the prompt cannot be built")
+				return nil, nil
+			},
+		})
+
+		call, err := client.Call(context.Background(), ultravox.WithCallDynamicSystemPrompt(func(context.Context) (string, error) {
+			return "", errLookup
+		}))
+		assert.Nil(t, call)
+		assert.ErrorIs(t, err, errLookup)
+	})
+}
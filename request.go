@@ -0,0 +1,84 @@
+package ultravox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+)
+
+// newRequest builds an API request for path, which is relative to the API base
+// URL and may include a query string. A non-nil body is sent as JSON.
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	var reqBody io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.config.APIBaseURL+path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+
+	c.setHeaders(req)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// do sends req and, when out is non-nil, decodes the JSON response into it.
+// Non-success statuses are returned as an *APIError.
+func (c *Client) do(req *http.Request, out interface{}) error {
+	_, err := c.doStatus(req, out)
+	return err
+}
+
+// doStatus is like do, also returning the HTTP status code of the response, or
+// zero when no response was received
+func (c *Client) doStatus(req *http.Request, out interface{}) (int, error) {
+	resp, err := c.send(req)
+	if err != nil {
+		return 0, fmt.Errorf("API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, newAPIError(resp)
+	}
+
+	if out == nil {
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode API response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// setHeaders applies the authentication and identification headers shared by all API requests
+func (c *Client) setHeaders(req *http.Request) {
+	if c.config.UserAgent != "" {
+		req.Header.Set("User-Agent", c.config.UserAgent)
+	}
+	addHeaders(req, c.config.Headers)
+	req.Header.Set("X-API-Key", c.config.APIKey)
+}
+
+// addHeaders sets user-supplied headers on req, replacing any earlier values
+// except for the API key, which is only ever taken from the client config
+func addHeaders(req *http.Request, headers http.Header) {
+	for key, values := range headers {
+		if http.CanonicalHeaderKey(key) == "X-Api-Key" {
+			continue
+		}
+		req.Header[http.CanonicalHeaderKey(key)] = slices.Clone(values)
+	}
+}
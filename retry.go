@@ -0,0 +1,198 @@
+package ultravox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy defines how failed API requests are retried. Idempotent
+// requests are retried on 429, 500, 502, 503 and 504 responses. Other
+// requests, such as creating a call, are retried only on 429 and 503, since
+// the server rejected those before acting on them; after any other error the
+// call may already exist, so retrying could create a duplicate.
+type RetryPolicy struct {
+	MaxAttempts  int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// retryableStatusCodes lists the HTTP statuses that are worth retrying
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// isIdempotent reports whether a request with the given method can safely be repeated
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether a request with the given method that got
+// a response with the given status code should be retried
+func isRetryableStatus(method string, code int) bool {
+	if isIdempotent(method) {
+		return retryableStatusCodes[code]
+	}
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+// isRetryableError reports whether a request that failed with the transport
+// error err should be retried. Idempotent requests can always be repeated;
+// other requests, such as creating a call, are only retried when the
+// connection was never established, since the server may otherwise have acted
+// on them already.
+func isRetryableError(method string, err error) bool {
+	if isIdempotent(method) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff returns the jittered delay to wait after the given attempt (starting at 1)
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// Pick a random delay in [delay/2, delay] so concurrent clients don't retry in lockstep
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// send dispatches a unary request, bounded by the client's HTTP timeout
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	return c.sendVia(c.http, req)
+}
+
+// sendStream dispatches a request whose body may take longer than the HTTP
+// timeout to read, leaving the overall deadline to the request's context
+func (c *Client) sendStream(req *http.Request) (*http.Response, error) {
+	return c.sendVia(c.streamHTTP, req)
+}
+
+// sendVia dispatches the request with the caller's trace context attached, recording
+// the outcome on its span and in the client's log
+func (c *Client) sendVia(httpClient HTTPClient, req *http.Request) (*http.Response, error) {
+	span := injectTraceContext(req)
+
+	breaker := c.config.CircuitBreaker
+	if err := breaker.allow(); err != nil {
+		c.logger.Warn("request rejected", "method", req.Method, "url", req.URL.String(), "error", err)
+		recordResponse(span, nil, err)
+		return nil, err
+	}
+
+	c.logger.Debug("sending request", "method", req.Method, "url", req.URL.String())
+
+	resp, err := c.sendWithRetry(httpClient, req)
+	breaker.record(req.Context(), resp, err)
+	recordResponse(span, resp, err)
+
+	switch {
+	case err != nil:
+		c.logger.Error("request failed", "method", req.Method, "url", req.URL.String(), "error", err)
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		c.logger.Error("API returned non-success status", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode)
+	default:
+		c.logger.Info("received response", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode)
+	}
+	return resp, err
+}
+
+// sendWithRetry dispatches the request, retrying retryable failures according to the configured policy
+func (c *Client) sendWithRetry(httpClient HTTPClient, req *http.Request) (*http.Response, error) {
+	policy := c.config.RetryPolicy
+	maxAttempts := 1
+	if policy != nil && policy.MaxAttempts > 1 {
+		maxAttempts = policy.MaxAttempts
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			attemptReq = req.Clone(withRetryAttempt(ctx, attempt))
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, fmt.Errorf("failed to rewind request body: %w", err)
+				}
+				attemptReq.Body = body
+			}
+		}
+
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := httpClient.Do(attemptReq)
+		var retryable bool
+		if err != nil {
+			retryable = isRetryableError(req.Method, err)
+		} else {
+			retryable = isRetryableStatus(req.Method, resp.StatusCode)
+		}
+		if !retryable || attempt >= maxAttempts || ctx.Err() != nil {
+			return resp, err
+		}
+
+		// Drain the body so the underlying connection can be reused
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		delay := policy.backoff(attempt)
+		c.logger.Warn("retrying request", "url", req.URL.String(), "attempt", attempt, "delay", delay)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// waitForRateLimit blocks until the configured rate limiter permits another request
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	limiter := c.config.RateLimiter
+	if limiter == nil {
+		return nil
+	}
+
+	if err := limiter.Wait(ctx); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// Wait fails early when the delay would outlast the context's deadline
+		if _, ok := ctx.Deadline(); ok {
+			return context.DeadlineExceeded
+		}
+		return fmt.Errorf("rate limiter: %w", err)
+	}
+	return nil
+}
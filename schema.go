@@ -0,0 +1,112 @@
+package ultravox
+
+import "encoding/json"
+
+// JSONSchema is a JSON Schema for a tool parameter, built with Schema and its
+// chainable setters. It can be used directly as DynamicParameter.Schema.
+type JSONSchema struct {
+	def schemaDefinition
+}
+
+// schemaDefinition holds the keywords of a JSON Schema in their wire format
+type schemaDefinition struct {
+	Type        string                 `json:"type,omitempty" yaml:"type,omitempty"`
+	Description string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Enum        []interface{}          `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Minimum     *float64               `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	Maximum     *float64               `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	Items       *JSONSchema            `json:"items,omitempty" yaml:"items,omitempty"`
+	Properties  map[string]*JSONSchema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// MarshalJSON encodes the schema as a JSON Schema document
+func (s *JSONSchema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.def)
+}
+
+// MarshalYAML encodes the schema as a JSON Schema document
+func (s *JSONSchema) MarshalYAML() (interface{}, error) {
+	return s.def, nil
+}
+
+// Schema starts a new, empty JSON Schema
+func Schema() *JSONSchema {
+	return &JSONSchema{}
+}
+
+// Object makes the schema describe a JSON object
+func (s *JSONSchema) Object() *JSONSchema {
+	s.def.Type = "object"
+	return s
+}
+
+// String makes the schema describe a string
+func (s *JSONSchema) String() *JSONSchema {
+	s.def.Type = "string"
+	return s
+}
+
+// Number makes the schema describe a number
+func (s *JSONSchema) Number() *JSONSchema {
+	s.def.Type = "number"
+	return s
+}
+
+// Integer makes the schema describe an integer
+func (s *JSONSchema) Integer() *JSONSchema {
+	s.def.Type = "integer"
+	return s
+}
+
+// Boolean makes the schema describe a boolean
+func (s *JSONSchema) Boolean() *JSONSchema {
+	s.def.Type = "boolean"
+	return s
+}
+
+// Array makes the schema describe an array whose elements match items
+func (s *JSONSchema) Array(items *JSONSchema) *JSONSchema {
+	s.def.Type = "array"
+	s.def.Items = items
+	return s
+}
+
+// Description sets the description shown to the model
+func (s *JSONSchema) Description(description string) *JSONSchema {
+	s.def.Description = description
+	return s
+}
+
+// Enum restricts the value to one of values
+func (s *JSONSchema) Enum(values ...interface{}) *JSONSchema {
+	s.def.Enum = values
+	return s
+}
+
+// Minimum sets the inclusive lower bound of a number or integer
+func (s *JSONSchema) Minimum(min float64) *JSONSchema {
+	s.def.Minimum = &min
+	return s
+}
+
+// Maximum sets the inclusive upper bound of a number or integer
+func (s *JSONSchema) Maximum(max float64) *JSONSchema {
+	s.def.Maximum = &max
+	return s
+}
+
+// Property adds a property to an object schema
+func (s *JSONSchema) Property(name string, property *JSONSchema) *JSONSchema {
+	if s.def.Properties == nil {
+		s.def.Properties = make(map[string]*JSONSchema)
+	}
+	s.def.Properties[name] = property
+	return s
+}
+
+// Required marks properties of an object schema as required
+func (s *JSONSchema) Required(names ...string) *JSONSchema {
+	s.def.Required = append(s.def.Required, names...)
+	return s
+}
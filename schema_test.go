@@ -0,0 +1,83 @@
+package ultravox_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   *ultravox.JSONSchema
+		wantJSON string
+	}{
+		{
+			name:     "String",
+			schema:   ultravox.Schema().String().Description("The city to look up"),
+			wantJSON: `{"type": "string", "description": "The city to look up"}`,
+		},
+		{
+			name:     "Enum",
+			schema:   ultravox.Schema().String().Enum("celsius", "fahrenheit"),
+			wantJSON: `{"type": "string", "enum": ["celsius", "fahrenheit"]}`,
+		},
+		{
+			name:     "Bounded integer",
+			schema:   ultravox.Schema().Integer().Minimum(0).Maximum(10),
+			wantJSON: `{"type": "integer", "minimum": 0, "maximum": 10}`,
+		},
+		{
+			name:     "Array",
+			schema:   ultravox.Schema().Array(ultravox.Schema().Number()),
+			wantJSON: `{"type": "array", "items": {"type": "number"}}`,
+		},
+		{
+			name: "Object",
+			schema: ultravox.Schema().Object().
+				Property("city", ultravox.Schema().String().Description("The city to look up")).
+				Property("days", ultravox.Schema().Integer()).
+				Property("alerts", ultravox.Schema().Boolean()).
+				Required("city"),
+			wantJSON: `{
+				"type": "object",
+				"properties": {
+					"city": {"type": "string", "description": "The city to look up"},
+					"days": {"type": "integer"},
+					"alerts": {"type": "boolean"}
+				},
+				"required": ["city"]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.schema)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantJSON, string(data))
+		})
+	}
+}
+
+func TestSchema_DynamicParameter(t *testing.T) {
+	param := ultravox.NewDynamicParameter("units", ultravox.ParameterLocationQuery,
+		ultravox.Schema().String().Enum("metric", "imperial"), true)
+
+	data, err := json.Marshal(param)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "units",
+		"location": "PARAMETER_LOCATION_QUERY",
+		"schema": {"type": "string", "enum": ["metric", "imperial"]},
+		"required": true
+	}`, string(data))
+
+	out, err := yaml.Marshal(param)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "schema:\n    type: string\n")
+}
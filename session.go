@@ -0,0 +1,453 @@
+package ultravox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Session keepalive and buffering parameters
+const (
+	sessionPingInterval = 20 * time.Second
+	sessionPongWait     = 60 * time.Second
+	sessionWriteWait    = 10 * time.Second
+	sessionBufferSize   = 64
+
+	// sessionReconnectBufferSize bounds the messages held while reconnecting,
+	// about two seconds of audio in 20 ms frames
+	sessionReconnectBufferSize = 100
+)
+
+// SessionOption configures a Session created by Join
+type SessionOption func(*sessionConfig)
+
+// sessionConfig holds the settings applied by SessionOptions
+type sessionConfig struct {
+	maxRetries int
+	backoff    time.Duration
+}
+
+// WithSessionReconnect makes the session re-dial the call's join URL when the
+// connection drops, making up to maxRetries attempts per drop. The first
+// attempt waits backoff and each further attempt waits twice as long as the
+// previous one. A ReconnectEvent is delivered through ReadEvent after each
+// reconnect. Messages sent while reconnecting are buffered and delivered once
+// connected; when the buffer is full, sends fail with ErrReconnectBufferFull.
+// A normal close by Ultravox, such as at the end of the call, still ends the
+// session.
+func WithSessionReconnect(maxRetries int, backoff time.Duration) SessionOption {
+	return func(c *sessionConfig) {
+		c.maxRetries = maxRetries
+		c.backoff = backoff
+	}
+}
+
+// pendingMessage is a message written while the session was reconnecting
+type pendingMessage struct {
+	messageType int
+	data        []byte
+}
+
+// inputTextMessage is the data message that delivers user text to the agent
+type inputTextMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// isDTMFDigit reports whether r is a key on a DTMF keypad
+func isDTMFDigit(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '*' || r == '#' || (r >= 'A' && r <= 'D')
+}
+
+// Session is a live WebSocket connection to an Ultravox call.
+// Incoming JSON messages are delivered through ReadEvent and binary PCM
+// frames through ReadAudio; both must be drained, since a full buffer on
+// either side pauses the connection's read loop.
+type Session struct {
+	events chan Event
+	audio  chan []byte
+	config sessionConfig
+	dial   func() (*websocket.Conn, error)
+
+	// writeMu serialises writes, since a connection supports only one
+	// concurrent writer
+	writeMu sync.Mutex
+
+	// connMu guards the connection, which is replaced on reconnect, and the
+	// messages buffered while reconnecting. It is taken after writeMu.
+	connMu       sync.Mutex
+	conn         *websocket.Conn
+	reconnecting bool
+	pending      []pendingMessage
+
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+// Join dials the call's join URL and returns a session for exchanging audio and
+// data messages. The session is closed when ctx is cancelled. When the call has
+// a join timeout, a dial that does not complete within it fails with an error
+// matching ErrJoinTimeout. Use WithSessionReconnect to survive dropped
+// connections.
+func (c *Client) Join(ctx context.Context, call *Call, opts ...SessionOption) (*Session, error) {
+	if call == nil || call.JoinURL == "" {
+		return nil, fmt.Errorf("call does not have a join URL")
+	}
+
+	var config sessionConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	dialCtx := ctx
+	if timeout := time.Duration(call.JoinTimeout); timeout > 0 {
+		var cancel context.CancelFunc
+		dialCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	conn, _, err := websocket.DefaultDialer.DialContext(dialCtx, call.JoinURL, nil)
+	if err != nil {
+		// The dial can fail on its I/O deadline just before dialCtx reports
+		// DeadlineExceeded, so the deadline itself is checked
+		if ctx.Err() == nil && joinDeadlinePassed(dialCtx) {
+			return nil, fmt.Errorf("%w after %s", ErrJoinTimeout, time.Duration(call.JoinTimeout))
+		}
+		return nil, fmt.Errorf("failed to connect to call: %w", err)
+	}
+
+	s := &Session{
+		conn:   conn,
+		events: make(chan Event, sessionBufferSize),
+		audio:  make(chan []byte, sessionBufferSize),
+		config: config,
+		dial: func() (*websocket.Conn, error) {
+			conn, _, err := websocket.DefaultDialer.DialContext(ctx, call.JoinURL, nil)
+			return conn, err
+		},
+		done: make(chan struct{}),
+	}
+	watchPongs(conn)
+
+	go s.readLoop()
+	go s.keepalive()
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.shutdown(ctx.Err())
+		case <-s.done:
+		}
+	}()
+
+	return s, nil
+}
+
+// joinDeadlinePassed reports whether ctx has a deadline that has been reached
+func joinDeadlinePassed(ctx context.Context) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && !time.Now().Before(deadline)
+}
+
+// ReadEvent blocks until the next data message arrives or the session ends
+func (s *Session) ReadEvent() (Event, error) {
+	select {
+	case ev := <-s.events:
+		return ev, nil
+	case <-s.done:
+		select {
+		case ev := <-s.events:
+			return ev, nil
+		default:
+			return nil, s.err
+		}
+	}
+}
+
+// ReadAudio blocks until the next PCM audio frame arrives or the session ends
+func (s *Session) ReadAudio() ([]byte, error) {
+	select {
+	case frame := <-s.audio:
+		return frame, nil
+	case <-s.done:
+		select {
+		case frame := <-s.audio:
+			return frame, nil
+		default:
+			return nil, s.err
+		}
+	}
+}
+
+// SendAudio sends a frame of PCM audio to the call
+func (s *Session) SendAudio(frame []byte) error {
+	return s.write(websocket.BinaryMessage, frame)
+}
+
+// SendText sends user text to the agent as if it had been spoken
+func (s *Session) SendText(text string) error {
+	return s.sendJSON(inputTextMessage{Type: "input_text_message", Text: text})
+}
+
+// SendDTMF sends keypad digits (0-9, *, #, A-D) to the agent. Ultravox has no
+// dedicated tone message, so the digits are delivered as user text.
+func (s *Session) SendDTMF(digits string) error {
+	if digits == "" {
+		return fmt.Errorf("DTMF digits are required")
+	}
+	for _, r := range digits {
+		if !isDTMFDigit(r) {
+			return fmt.Errorf("invalid DTMF digit %q", r)
+		}
+	}
+	return s.SendText(digits)
+}
+
+// Close ends the session. Pending reads return ErrSessionClosed.
+func (s *Session) Close() error {
+	s.shutdown(ErrSessionClosed)
+	return nil
+}
+
+// sendJSON sends a data message to the call
+func (s *Session) sendJSON(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	return s.write(websocket.TextMessage, data)
+}
+
+// write sends a message on the current connection, or buffers it while the
+// session is reconnecting
+func (s *Session) write(messageType int, data []byte) error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	s.connMu.Lock()
+	if s.reconnecting {
+		defer s.connMu.Unlock()
+		return s.buffer(messageType, data)
+	}
+	conn := s.conn
+	s.connMu.Unlock()
+
+	conn.SetWriteDeadline(time.Now().Add(sessionWriteWait))
+	err := conn.WriteMessage(messageType, data)
+	if err == nil {
+		return nil
+	}
+	if s.config.maxRetries > 0 {
+		// Closing the connection wakes the read loop, which reconnects
+		s.connMu.Lock()
+		defer s.connMu.Unlock()
+		s.reconnecting = true
+		conn.Close()
+		return s.buffer(messageType, data)
+	}
+	return fmt.Errorf("failed to write to session: %w", err)
+}
+
+// buffer holds a message until the session has reconnected. connMu must be held.
+func (s *Session) buffer(messageType int, data []byte) error {
+	if len(s.pending) >= sessionReconnectBufferSize {
+		return ErrReconnectBufferFull
+	}
+	// Copy the data, since callers may reuse their buffers once write returns
+	s.pending = append(s.pending, pendingMessage{messageType: messageType, data: slices.Clone(data)})
+	return nil
+}
+
+// currentConn returns the connection in use
+func (s *Session) currentConn() *websocket.Conn {
+	s.connMu.Lock()
+	defer s.connMu.Unlock()
+	return s.conn
+}
+
+// watchPongs extends the read deadline of conn each time the server answers a ping
+func watchPongs(conn *websocket.Conn) {
+	conn.SetReadDeadline(time.Now().Add(sessionPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(sessionPongWait))
+	})
+}
+
+// readLoop dispatches incoming messages until the connection closes, or fails
+// and cannot be reconnected
+func (s *Session) readLoop() {
+	conn := s.currentConn()
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				s.shutdown(io.EOF)
+				return
+			}
+			if s.config.maxRetries > 0 {
+				// The failed connection may still hold its socket open
+				conn.Close()
+				if conn, err = s.reconnect(err); err == nil {
+					continue
+				}
+			}
+			s.shutdown(err)
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(sessionPongWait))
+
+		switch messageType {
+		case websocket.TextMessage:
+			ev, err := DecodeEvent(data)
+			if err != nil {
+				continue
+			}
+
+			select {
+			case s.events <- ev:
+			case <-s.done:
+				return
+			}
+		case websocket.BinaryMessage:
+			select {
+			case s.audio <- data:
+			case <-s.done:
+				return
+			}
+		}
+	}
+}
+
+// reconnect re-dials the call after its connection failed with cause, backing
+// off between attempts, and returns the new connection once buffered messages
+// have been sent on it
+func (s *Session) reconnect(cause error) (*websocket.Conn, error) {
+	s.connMu.Lock()
+	s.reconnecting = true
+	s.connMu.Unlock()
+
+	err := cause
+	delay := s.config.backoff
+	for attempt := 1; attempt <= s.config.maxRetries; attempt++ {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-s.done:
+			timer.Stop()
+			return nil, s.err
+		}
+		delay *= 2
+
+		var conn *websocket.Conn
+		if conn, err = s.dial(); err != nil {
+			continue
+		}
+		if err = s.resume(conn); err != nil {
+			conn.Close()
+			continue
+		}
+
+		select {
+		case s.events <- &ReconnectEvent{Attempt: attempt, Err: cause}:
+		case <-s.done:
+		}
+		return conn, nil
+	}
+	return nil, fmt.Errorf("failed to reconnect after %d attempts: %w", s.config.maxRetries, err)
+}
+
+// resume makes conn the session's connection and flushes the messages
+// buffered while reconnecting
+func (s *Session) resume(conn *websocket.Conn) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.connMu.Lock()
+	defer s.connMu.Unlock()
+
+	select {
+	case <-s.done:
+		return s.err
+	default:
+	}
+
+	watchPongs(conn)
+	for len(s.pending) > 0 {
+		msg := s.pending[0]
+		conn.SetWriteDeadline(time.Now().Add(sessionWriteWait))
+		if err := conn.WriteMessage(msg.messageType, msg.data); err != nil {
+			return fmt.Errorf("failed to flush buffered messages: %w", err)
+		}
+		s.pending = s.pending[1:]
+	}
+
+	s.pending = nil
+	s.conn = conn
+	s.reconnecting = false
+	return nil
+}
+
+// keepalive pings the server periodically so idle connections stay open
+func (s *Session) keepalive() {
+	ticker := time.NewTicker(sessionPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.connMu.Lock()
+			conn, reconnecting := s.conn, s.reconnecting
+			s.connMu.Unlock()
+			if reconnecting {
+				continue
+			}
+
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(sessionWriteWait)); err != nil {
+				if s.config.maxRetries > 0 {
+					// Closing the connection wakes the read loop, which reconnects
+					conn.Close()
+					continue
+				}
+				s.shutdown(err)
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// shutdown closes the connection once, recording the reason for later reads
+func (s *Session) shutdown(err error) {
+	s.closeOnce.Do(func() {
+		if err == nil {
+			err = ErrSessionClosed
+		}
+		s.err = err
+		// done is closed before the connection so that the read loop sees
+		// the shutdown rather than trying to reconnect
+		close(s.done)
+
+		conn := s.currentConn()
+		closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second))
+		conn.Close()
+	})
+}
@@ -0,0 +1,364 @@
+package ultravox_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSessionServer starts a WebSocket server running handler for each connection
+func newSessionServer(t *testing.T, handler func(conn *websocket.Conn)) *ultravox.Call {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+	t.Cleanup(server.Close)
+
+	return &ultravox.Call{JoinURL: "ws" + strings.TrimPrefix(server.URL, "http")}
+}
+
+func TestSession(t *testing.T) {
+	t.Run("Reads events and audio", func(t *testing.T) {
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"state","state":"listening"}`))
+			conn.WriteMessage(websocket.BinaryMessage, []byte{0x01, 0x02})
+			conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			conn.ReadMessage()
+		})
+
+		session, err := ultravox.NewClient().Join(context.Background(), call)
+		require.NoError(t, err)
+		defer session.Close()
+
+		ev, err := session.ReadEvent()
+		require.NoError(t, err)
+		require.IsType(t, &ultravox.StateEvent{}, ev)
+		assert.Equal(t, "listening", ev.(*ultravox.StateEvent).State)
+
+		frame, err := session.ReadAudio()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x01, 0x02}, frame)
+
+		_, err = session.ReadEvent()
+		assert.Error(t, err)
+	})
+
+	t.Run("Sends audio", func(t *testing.T) {
+		received := make(chan []byte, 1)
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			_, data, err := conn.ReadMessage()
+			if err == nil {
+				received <- data
+			}
+		})
+
+		session, err := ultravox.NewClient().Join(context.Background(), call)
+		require.NoError(t, err)
+		defer session.Close()
+
+		require.NoError(t, session.SendAudio([]byte{0x0a, 0x0b}))
+
+		select {
+		case data := <-received:
+			assert.Equal(t, []byte{0x0a, 0x0b}, data)
+		case <-time.After(time.Second):
+			t.Fatal("server did not receive audio")
+		}
+	})
+
+	t.Run("Sends DTMF digits", func(t *testing.T) {
+		received := make(chan []byte, 1)
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			messageType, data, err := conn.ReadMessage()
+			if err == nil && messageType == websocket.TextMessage {
+				received <- data
+			}
+		})
+
+		session, err := ultravox.NewClient().Join(context.Background(), call)
+		require.NoError(t, err)
+		defer session.Close()
+
+		assert.Error(t, session.SendDTMF("12x"))
+		assert.Error(t, session.SendDTMF(""))
+		require.NoError(t, session.SendDTMF("12#"))
+
+		select {
+		case data := <-received:
+			assert.Equal(t, `{"type":"input_text_message","text":"12#"}`, string(data))
+		case <-time.After(time.Second):
+			t.Fatal("server did not receive DTMF message")
+		}
+	})
+
+	t.Run("Closes on context cancellation", func(t *testing.T) {
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			conn.ReadMessage()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		session, err := ultravox.NewClient().Join(ctx, call)
+		require.NoError(t, err)
+
+		cancel()
+
+		_, err = session.ReadEvent()
+		assert.True(t, errors.Is(err, context.Canceled))
+
+		err = session.SendAudio([]byte{0x00})
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+
+	t.Run("Close ends pending reads", func(t *testing.T) {
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			conn.ReadMessage()
+		})
+
+		session, err := ultravox.NewClient().Join(context.Background(), call)
+		require.NoError(t, err)
+
+		require.NoError(t, session.Close())
+
+		_, err = session.ReadAudio()
+		assert.ErrorIs(t, err, ultravox.ErrSessionClosed)
+	})
+
+	t.Run("Requires a join URL", func(t *testing.T) {
+		_, err := ultravox.NewClient().Join(context.Background(), &ultravox.Call{})
+		assert.Error(t, err)
+	})
+
+	t.Run("Join timeout", func(t *testing.T) {
+		// The server accepts the connection but never completes the handshake
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(release) })
+
+		call := &ultravox.Call{
+			JoinURL:     "ws" + strings.TrimPrefix(server.URL, "http"),
+			JoinTimeout: ultravox.UltravoxDuration(50 * time.Millisecond),
+		}
+
+		_, err := ultravox.NewClient().Join(context.Background(), call)
+		assert.ErrorIs(t, err, ultravox.ErrJoinTimeout)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = ultravox.NewClient().Join(ctx, call)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ultravox.ErrJoinTimeout, "a cancelled context is not a join timeout")
+	})
+
+	t.Run("Join timeout does not limit the session", func(t *testing.T) {
+		call := newSessionServer(t, func(conn *websocket.Conn) {
+			time.Sleep(100 * time.Millisecond)
+			conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"state","state":"listening"}`))
+			conn.ReadMessage()
+		})
+		call.JoinTimeout = ultravox.UltravoxDuration(20 * time.Millisecond)
+
+		session, err := ultravox.NewClient().Join(context.Background(), call)
+		require.NoError(t, err)
+		defer session.Close()
+
+		ev, err := session.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, &ultravox.StateEvent{State: "listening"}, ev)
+	})
+}
+
+// newReconnectServer starts a WebSocket server that passes each connection to
+// the handler for its attempt number, starting at 1, after calling before with
+// that number. Attempts without a handler, or with a nil one, are rejected.
+func newReconnectServer(t *testing.T, before func(attempt int), handlers ...func(conn *websocket.Conn)) *ultravox.Call {
+	t.Helper()
+
+	var attempts atomic.Int32
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(attempts.Add(1))
+		if before != nil {
+			before(attempt)
+		}
+		if attempt > len(handlers) || handlers[attempt-1] == nil {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handlers[attempt-1](conn)
+	}))
+	t.Cleanup(server.Close)
+
+	return &ultravox.Call{JoinURL: "ws" + strings.TrimPrefix(server.URL, "http")}
+}
+
+// dropConnection closes conn without a close frame, as a network failure would
+func dropConnection(conn *websocket.Conn) {
+	conn.UnderlyingConn().Close()
+}
+
+func TestSession_Reconnect(t *testing.T) {
+	t.Run("Buffers messages while reconnecting", func(t *testing.T) {
+		reconnecting := make(chan struct{})
+		buffered := make(chan struct{})
+		received := make(chan string, 1)
+
+		call := newReconnectServer(t,
+			func(attempt int) {
+				// Hold the rejected second attempt until the test has sent messages
+				if attempt == 2 {
+					close(reconnecting)
+					<-buffered
+				}
+			},
+			func(conn *websocket.Conn) {
+				conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"state","state":"listening"}`))
+				dropConnection(conn)
+			},
+			nil,
+			func(conn *websocket.Conn) {
+				if _, data, err := conn.ReadMessage(); err == nil {
+					received <- string(data)
+				}
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						return
+					}
+				}
+			},
+		)
+
+		session, err := ultravox.NewClient().Join(context.Background(), call, ultravox.WithSessionReconnect(3, 10*time.Millisecond))
+		require.NoError(t, err)
+		defer session.Close()
+
+		ev, err := session.ReadEvent()
+		require.NoError(t, err)
+		assert.Equal(t, &ultravox.StateEvent{State: "listening"}, ev)
+
+		select {
+		case <-reconnecting:
+		case <-time.After(time.Second):
+			t.Fatal("session did not reconnect")
+		}
+
+		require.NoError(t, session.SendText("hello"))
+		for i := 0; ; i++ {
+			require.Less(t, i, 1000, "the reconnect buffer is not bounded")
+			if err = session.SendAudio([]byte{0x01, 0x02}); err != nil {
+				break
+			}
+		}
+		assert.ErrorIs(t, err, ultravox.ErrReconnectBufferFull)
+		close(buffered)
+
+		ev, err = session.ReadEvent()
+		require.NoError(t, err)
+		require.IsType(t, &ultravox.ReconnectEvent{}, ev)
+		assert.Equal(t, 2, ev.(*ultravox.ReconnectEvent).Attempt)
+		assert.Error(t, ev.(*ultravox.ReconnectEvent).Err)
+
+		select {
+		case data := <-received:
+			assert.Equal(t, `{"type":"input_text_message","text":"hello"}`, data)
+		case <-time.After(time.Second):
+			t.Fatal("server did not receive the buffered message")
+		}
+		require.NoError(t, session.SendAudio([]byte{0x03, 0x04}))
+	})
+
+	t.Run("Gives up after max retries", func(t *testing.T) {
+		call := newReconnectServer(t, nil, func(conn *websocket.Conn) {
+			dropConnection(conn)
+		})
+
+		session, err := ultravox.NewClient().Join(context.Background(), call, ultravox.WithSessionReconnect(2, 5*time.Millisecond))
+		require.NoError(t, err)
+		defer session.Close()
+
+		_, err = session.ReadEvent()
+		assert.ErrorContains(t, err, "failed to reconnect after 2 attempts")
+		assert.Error(t, session.SendAudio([]byte{0x01}))
+	})
+
+	t.Run("Closes the failed connection", func(t *testing.T) {
+		closed := make(chan struct{})
+		call := newReconnectServer(t, nil,
+			func(conn *websocket.Conn) {
+				// An abnormal close leaves the socket open until the client closes it
+				conn.WriteControl(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseInternalServerErr, ""), time.Now().Add(time.Second))
+				io.Copy(io.Discard, conn.UnderlyingConn())
+				close(closed)
+			},
+			func(conn *websocket.Conn) {
+				conn.ReadMessage()
+			},
+		)
+
+		session, err := ultravox.NewClient().Join(context.Background(), call, ultravox.WithSessionReconnect(3, time.Millisecond))
+		require.NoError(t, err)
+		defer session.Close()
+
+		ev, err := session.ReadEvent()
+		require.NoError(t, err)
+		assert.IsType(t, &ultravox.ReconnectEvent{}, ev)
+
+		select {
+		case <-closed:
+		case <-time.After(time.Second):
+			t.Fatal("the failed connection was not closed")
+		}
+	})
+
+	t.Run("Normal close ends the session", func(t *testing.T) {
+		call := newReconnectServer(t,
+			func(attempt int) {
+				assert.Equal(t, 1, attempt, "a normal close is not reconnected")
+			},
+			func(conn *websocket.Conn) {
+				conn.WriteMessage(websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				conn.ReadMessage()
+			},
+		)
+
+		session, err := ultravox.NewClient().Join(context.Background(), call, ultravox.WithSessionReconnect(3, time.Millisecond))
+		require.NoError(t, err)
+		defer session.Close()
+
+		_, err = session.ReadEvent()
+		assert.ErrorIs(t, err, io.EOF)
+		time.Sleep(20 * time.Millisecond)
+	})
+}
@@ -0,0 +1,255 @@
+package ultravox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// callStageRequest is the request body for creating a call stage. It carries
+// only the CallRequest fields that can change between stages; anything left
+// unset is inherited from the current stage.
+type callStageRequest struct {
+	SystemPrompt         string         `json:"systemPrompt,omitempty"`
+	Temperature          float64        `json:"temperature,omitempty"`
+	Voice                string         `json:"voice,omitempty"`
+	ExternalVoice        *ExternalVoice `json:"externalVoice,omitempty"`
+	LanguageHint         string         `json:"languageHint,omitempty"`
+	InitialMessages      []Message      `json:"initialMessages,omitempty"`
+	TimeExceededMessage  string         `json:"timeExceededMessage,omitempty"`
+	InactivityMessages   []TimedMessage `json:"inactivityMessages,omitempty"`
+	SelectedTools        []SelectedTool `json:"selectedTools,omitempty"`
+	ExperimentalSettings interface{}    `json:"experimentalSettings,omitempty"`
+	InitialState         interface{}    `json:"initialState,omitempty"`
+}
+
+// newCallStageRequest copies the stage-level settings out of r
+func newCallStageRequest(r *CallRequest) *callStageRequest {
+	return &callStageRequest{
+		SystemPrompt:         r.SystemPrompt,
+		Temperature:          r.Temperature,
+		Voice:                r.Voice,
+		ExternalVoice:        r.ExternalVoice,
+		LanguageHint:         r.LanguageHint,
+		InitialMessages:      r.InitialMessages,
+		TimeExceededMessage:  r.TimeExceededMessage,
+		InactivityMessages:   r.InactivityMessages,
+		SelectedTools:        r.SelectedTools,
+		ExperimentalSettings: r.ExperimentalSettings,
+		InitialState:         r.InitialState,
+	}
+}
+
+// WithCallStageSystemPrompt sets the system prompt for a new call stage
+func WithCallStageSystemPrompt(prompt string) CallOption {
+	return WithCallSystemPrompt(prompt)
+}
+
+// WithCallStageVoice sets the voice for a new call stage
+func WithCallStageVoice(voice string) CallOption {
+	return WithCallVoice(voice)
+}
+
+// WithCallStageTools replaces the tools available in a new call stage
+func WithCallStageTools(tools ...SelectedTool) CallOption {
+	return func(r *CallRequest) {
+		r.SelectedTools = append([]SelectedTool(nil), tools...)
+	}
+}
+
+// CreateCallStage moves an active call into a new stage configured by opts.
+// Only stage-level settings such as the system prompt, voice, messages and
+// tools are sent; call-level settings like the medium are ignored, and the
+// client's call defaults are not applied.
+func (c *Client) CreateCallStage(ctx context.Context, callID string, opts ...CallOption) (*CallStage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if callID == "" {
+		return nil, fmt.Errorf("call ID is required")
+	}
+
+	var request CallRequest
+	for _, opt := range opts {
+		opt(&request)
+	}
+	if request.optionErr != nil {
+		return nil, request.optionErr
+	}
+	if err := request.resolveDynamicSystemPrompt(ctx); err != nil {
+		return nil, err
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.create_call_stage", attribute.String("call.id", callID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/calls/"+url.PathEscape(callID)+"/stages", newCallStageRequest(&request))
+	if err != nil {
+		return nil, err
+	}
+
+	var stage CallStage
+	if err := c.do(req, &stage); err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("call.stage_id", stage.CallStageID))
+
+	return &stage, nil
+}
+
+// StageDefinition describes one phase of a multi-stage call
+type StageDefinition struct {
+	Name          string         `json:"name" yaml:"name"`
+	SystemPrompt  string         `json:"systemPrompt" yaml:"systemPrompt"`
+	SelectedTools []SelectedTool `json:"selectedTools,omitempty" yaml:"selectedTools,omitempty"`
+}
+
+// Options returns the call options that configure a new call stage from the
+// definition, for use with CreateCallStage
+func (s StageDefinition) Options() []CallOption {
+	return []CallOption{
+		WithCallStageSystemPrompt(s.SystemPrompt),
+		WithCallStageTools(s.SelectedTools...),
+	}
+}
+
+// stageTransition routes a tool selected in one stage to the stage it moves the call to
+type stageTransition struct {
+	from, toolName, to string
+}
+
+// StageBuilder accumulates the stages of a multi-stage call. The first stage
+// added is the one the call starts in. Transitions are routed by the client:
+// the handler of a transition tool looks up the next stage with Next and
+// creates it with CreateCallStage.
+type StageBuilder struct {
+	stages      []StageDefinition
+	transitions []stageTransition
+}
+
+// NewStageBuilder returns an empty stage builder
+func NewStageBuilder() *StageBuilder {
+	return &StageBuilder{}
+}
+
+// AddStage appends a stage with its own system prompt and tools
+func (b *StageBuilder) AddStage(name, prompt string, tools ...SelectedTool) *StageBuilder {
+	b.stages = append(b.stages, StageDefinition{
+		Name:          name,
+		SystemPrompt:  prompt,
+		SelectedTools: append([]SelectedTool(nil), tools...),
+	})
+	return b
+}
+
+// AddTransition makes the tool named toolName, which must be selected in the
+// stage called from, move the call to the stage called to
+func (b *StageBuilder) AddTransition(from, toolName, to string) *StageBuilder {
+	b.transitions = append(b.transitions, stageTransition{from: from, toolName: toolName, to: to})
+	return b
+}
+
+// Stage returns the stage called name
+func (b *StageBuilder) Stage(name string) (StageDefinition, bool) {
+	for _, stage := range b.stages {
+		if stage.Name == name {
+			return stage, true
+		}
+	}
+	return StageDefinition{}, false
+}
+
+// Next returns the stage that the tool named toolName moves the call to from
+// the stage called from, typically so that the tool's handler can create it
+// with CreateCallStage
+func (b *StageBuilder) Next(from, toolName string) (StageDefinition, bool) {
+	for _, t := range b.transitions {
+		if t.from == from && t.toolName == toolName {
+			return b.Stage(t.to)
+		}
+	}
+	return StageDefinition{}, false
+}
+
+// Build returns a call option that starts the call in the first stage. The
+// current stage's name is recorded in the call's initial state under "stage"
+// and every stage name under "stages", merged into any initial state set
+// earlier, so apply it after WithCallInitialState. Stage names must be unique
+// and every transition must connect known stages through a tool selected in
+// its source stage; violations are returned by Call.
+func (b *StageBuilder) Build() CallOption {
+	stages := slices.Clone(b.stages)
+	err := validateStages(stages, b.transitions)
+
+	names := make([]string, len(stages))
+	for i, stage := range stages {
+		names[i] = stage.Name
+	}
+
+	return func(r *CallRequest) {
+		if err != nil {
+			r.setOptionErr(fmt.Errorf("invalid stages: %w", err))
+			return
+		}
+
+		state := map[string]interface{}{}
+		if r.InitialState != nil {
+			if err := decodeCallState(r.InitialState, &state); err != nil {
+				r.setOptionErr(fmt.Errorf("cannot add stages to initial state of type %T: %w", r.InitialState, err))
+				return
+			}
+		}
+		state["stage"] = names[0]
+		state["stages"] = names
+
+		first := stages[0]
+		r.SystemPrompt = first.SystemPrompt
+		r.SelectedTools = append([]SelectedTool(nil), first.SelectedTools...)
+		r.InitialState = state
+	}
+}
+
+// validateStages checks that stages is non-empty, that names are unique and
+// that every transition leaves through a tool of a defined stage for another
+// defined stage
+func validateStages(stages []StageDefinition, transitions []stageTransition) error {
+	if len(stages) == 0 {
+		return errors.New("at least one stage is required")
+	}
+
+	byName := make(map[string]StageDefinition, len(stages))
+	var errs []error
+	for _, stage := range stages {
+		if stage.Name == "" {
+			errs = append(errs, errors.New("stage name is required"))
+			continue
+		}
+		if _, ok := byName[stage.Name]; ok {
+			errs = append(errs, fmt.Errorf("duplicate stage %q", stage.Name))
+			continue
+		}
+		byName[stage.Name] = stage
+	}
+
+	for _, t := range transitions {
+		from, ok := byName[t.from]
+		if !ok {
+			errs = append(errs, fmt.Errorf("transition from unknown stage %q", t.from))
+			continue
+		}
+		if _, ok := byName[t.to]; !ok {
+			errs = append(errs, fmt.Errorf("stage %q: transition to unknown stage %q", t.from, t.to))
+		}
+		if !slices.ContainsFunc(from.SelectedTools, func(tool SelectedTool) bool { return tool.hasName(t.toolName) }) {
+			errs = append(errs, fmt.Errorf("stage %q: transition tool %q is not selected", t.from, t.toolName))
+		}
+	}
+
+	return errors.Join(errs...)
+}
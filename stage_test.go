@@ -0,0 +1,192 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateCallStage(t *testing.T) {
+	var body map[string]interface{}
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/calls/call-123/stages", req.URL.String())
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"callId": "call-123",
+					"callStageId": "stage-2",
+					"systemPrompt": "You are a billing specialist.",
+					"voice": "Mark",
+					"model": "fixie-ai/ultravox"
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		ultravox.WithSystemPrompt("You are a receptionist."),
+	)
+	client.WithHTTPClient(mockClient)
+
+	stage, err := client.CreateCallStage(context.Background(), "call-123",
+		ultravox.WithCallStageSystemPrompt("You are a billing specialist."),
+		ultravox.WithCallStageVoice("Mark"),
+		ultravox.WithCallStageTools(ultravox.SelectedTool{ToolName: "lookupInvoice"}),
+		ultravox.WithCallWebRTCMedium(),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "stage-2", stage.CallStageID)
+	assert.Equal(t, "Mark", stage.Voice)
+
+	assert.Equal(t, map[string]interface{}{
+		"systemPrompt":  "You are a billing specialist.",
+		"voice":         "Mark",
+		"selectedTools": []interface{}{map[string]interface{}{"toolName": "lookupInvoice"}},
+	}, body, "only stage-level settings are sent and client defaults are not applied")
+
+	t.Run("Missing call ID", func(t *testing.T) {
+		_, err := client.CreateCallStage(context.Background(), "")
+		assert.Error(t, err)
+	})
+
+	t.Run("Option error", func(t *testing.T) {
+		_, err := client.CreateCallStage(context.Background(), "call-123",
+			ultravox.WithCallSystemPromptTemplate("Hello {{.Missing", nil),
+		)
+		assert.Error(t, err)
+	})
+}
+
+func TestStageBuilder(t *testing.T) {
+	builder := ultravox.NewStageBuilder().
+		AddStage("greeting", "Greet the caller and ask what they need.",
+			ultravox.SelectedTool{ToolName: "toBilling"},
+		).
+		AddStage("billing", "Help the caller with their invoice.",
+			ultravox.SelectedTool{ToolName: "lookupInvoice", AuthTokens: map[string]string{"Authorization": "secret"}},
+			ultravox.SelectedTool{ToolName: "toGreeting"},
+		).
+		AddTransition("greeting", "toBilling", "billing").
+		AddTransition("billing", "toGreeting", "greeting")
+
+	var request ultravox.CallRequest
+	builder.Build()(&request)
+	require.NoError(t, request.Validate())
+
+	assert.Equal(t, "Greet the caller and ask what they need.", request.SystemPrompt)
+	assert.Equal(t, []ultravox.SelectedTool{{ToolName: "toBilling"}}, request.SelectedTools)
+
+	data, err := json.Marshal(request)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "transitionId")
+	assert.NotContains(t, string(data), "secret", "tools of later stages are not sent")
+
+	state, err := json.Marshal(request.InitialState)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"stage": "greeting", "stages": ["greeting", "billing"]}`, string(state))
+
+	t.Run("Next stage", func(t *testing.T) {
+		billing, ok := builder.Next("greeting", "toBilling")
+		require.True(t, ok)
+		assert.Equal(t, "billing", billing.Name)
+
+		var stageRequest ultravox.CallRequest
+		for _, opt := range billing.Options() {
+			opt(&stageRequest)
+		}
+		assert.Equal(t, "Help the caller with their invoice.", stageRequest.SystemPrompt)
+		assert.Len(t, stageRequest.SelectedTools, 2)
+
+		_, ok = builder.Next("billing", "toBilling")
+		assert.False(t, ok)
+		_, ok = builder.Stage("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("Merges initial state", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallInitialStateJSON(map[string]interface{}{"customerId": "c-1"})(&request)
+		builder.Build()(&request)
+
+		state, err := json.Marshal(request.InitialState)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"customerId": "c-1", "stage": "greeting", "stages": ["greeting", "billing"]}`, string(state))
+	})
+
+	t.Run("Initial state that is not an object", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		_, err := client.Call(context.Background(), ultravox.WithCallInitialState([]string{"a"}), builder.Build())
+		assert.ErrorContains(t, err, "cannot add stages to initial state")
+	})
+}
+
+func TestStageBuilder_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *ultravox.StageBuilder
+		wantErr string
+	}{
+		{
+			name:    "No stages",
+			builder: ultravox.NewStageBuilder(),
+			wantErr: "at least one stage is required",
+		},
+		{
+			name: "Duplicate stage",
+			builder: ultravox.NewStageBuilder().
+				AddStage("greeting", "Hello").
+				AddStage("greeting", "Hello again"),
+			wantErr: `duplicate stage "greeting"`,
+		},
+		{
+			name: "Unknown transition target",
+			builder: ultravox.NewStageBuilder().
+				AddStage("greeting", "Hello", ultravox.SelectedTool{ToolName: "toSales"}).
+				AddTransition("greeting", "toSales", "sales"),
+			wantErr: `transition to unknown stage "sales"`,
+		},
+		{
+			name: "Unknown transition source",
+			builder: ultravox.NewStageBuilder().
+				AddStage("greeting", "Hello").
+				AddTransition("sales", "toGreeting", "greeting"),
+			wantErr: `transition from unknown stage "sales"`,
+		},
+		{
+			name: "Transition tool not selected",
+			builder: ultravox.NewStageBuilder().
+				AddStage("greeting", "Hello").
+				AddStage("sales", "Sell").
+				AddTransition("greeting", "toSales", "sales"),
+			wantErr: `transition tool "toSales" is not selected`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+			client.WithHTTPClient(&MockHTTPClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					t.Fatal("no request should be sent for invalid stages")
+					return nil, nil
+				},
+			})
+
+			_, err := client.Call(context.Background(), tt.builder.Build())
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
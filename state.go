@@ -0,0 +1,53 @@
+package ultravox
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WithCallInitialStateJSON sets the initial state for a specific call to v as
+// it would be encoded to JSON. Unlike WithCallInitialState, a value that cannot
+// be encoded is reported by Call before any request is sent. The state is
+// stored as plain maps and slices, so it survives SaveYAML and LoadCallRequest.
+func WithCallInitialStateJSON(v any) CallOption {
+	var state interface{}
+	data, err := json.Marshal(v)
+	if err == nil {
+		err = json.Unmarshal(data, &state)
+	}
+	return func(r *CallRequest) {
+		if err != nil {
+			r.setOptionErr(fmt.Errorf("failed to encode initial state: %w", err))
+			return
+		}
+		r.InitialState = state
+	}
+}
+
+// CallStateAs decodes the call's initial state into target, which must be a pointer
+func (c *Call) CallStateAs(target any) error {
+	return decodeCallState(c.InitialState, target)
+}
+
+// CallStateAs decodes the call state recorded with the message into target,
+// which must be a pointer
+func (m *Message) CallStateAs(target any) error {
+	return decodeCallState(m.CallState, target)
+}
+
+// decodeCallState converts state, as set by the caller or decoded from the
+// API, into target by way of JSON
+func decodeCallState(state interface{}, target any) error {
+	if state == nil {
+		return ErrNoCallState
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode call state: %w", err)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("failed to decode call state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderState struct {
+	OrderID string   `json:"orderId"`
+	Items   []string `json:"items"`
+	Paid    bool     `json:"paid"`
+}
+
+func TestCallState_RoundTrip(t *testing.T) {
+	state := orderState{OrderID: "order-42", Items: []string{"coffee", "bagel"}}
+
+	var sent json.RawMessage
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				InitialState json.RawMessage `json:"initialState"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			sent = body.InitialState
+
+			// The API echoes the initial state back on the call
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"callId": "call-123",
+					"joinUrl": "wss://example.com/join",
+					"initialState": ` + string(body.InitialState) + `
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	call, err := client.Call(context.Background(), ultravox.WithCallInitialStateJSON(state))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"orderId": "order-42", "items": ["coffee", "bagel"], "paid": false}`, string(sent))
+
+	var decoded orderState
+	require.NoError(t, call.CallStateAs(&decoded))
+	assert.Equal(t, state, decoded)
+
+	t.Run("Message state", func(t *testing.T) {
+		var message ultravox.Message
+		require.NoError(t, json.Unmarshal([]byte(`{"role": "MESSAGE_ROLE_AGENT", "callState": {"orderId": "order-42", "paid": true}}`), &message))
+
+		var decoded orderState
+		require.NoError(t, message.CallStateAs(&decoded))
+		assert.Equal(t, orderState{OrderID: "order-42", Paid: true}, decoded)
+	})
+
+	t.Run("No state", func(t *testing.T) {
+		var decoded orderState
+		assert.ErrorIs(t, (&ultravox.Call{}).CallStateAs(&decoded), ultravox.ErrNoCallState)
+	})
+
+	t.Run("Mismatched state", func(t *testing.T) {
+		call := ultravox.Call{InitialState: map[string]interface{}{"paid": "yes"}}
+		var decoded orderState
+		assert.ErrorContains(t, call.CallStateAs(&decoded), "failed to decode call state")
+	})
+}
+
+func TestWithCallInitialStateJSON_Invalid(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("no request should be sent for an unencodable state")
+			return nil, nil
+		},
+	})
+
+	_, err := client.Call(context.Background(), ultravox.WithCallInitialStateJSON(map[string]interface{}{"callback": func() {}}))
+	assert.ErrorContains(t, err, "failed to encode initial state")
+}
+
+func TestWithCallInitialStateJSON_YAMLRoundTrip(t *testing.T) {
+	var original ultravox.CallRequest
+	ultravox.WithCallInitialStateJSON(orderState{OrderID: "order-42", Items: []string{"coffee"}, Paid: true})(&original)
+
+	var buf bytes.Buffer
+	require.NoError(t, original.SaveYAML(&buf))
+	assert.Contains(t, buf.String(), "orderId: order-42")
+
+	loaded, err := ultravox.LoadCallRequest(&buf)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(loaded)
+	require.NoError(t, err)
+	var body struct {
+		InitialState json.RawMessage `json:"initialState"`
+	}
+	require.NoError(t, json.Unmarshal(data, &body))
+	assert.JSONEq(t, `{"orderId": "order-42", "items": ["coffee"], "paid": true}`, string(body.InitialState))
+}
@@ -0,0 +1,141 @@
+package ultravox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StoredTool is a reusable tool saved in the account, selected for a call by
+// its ToolID or Name
+type StoredTool struct {
+	ToolID     string             `json:"toolId" yaml:"toolId"`
+	Name       string             `json:"name" yaml:"name"`
+	Created    string             `json:"created" yaml:"created"`
+	Definition BaseToolDefinition `json:"definition" yaml:"definition"`
+}
+
+// storedToolRequest is the request body for creating or replacing a tool
+type storedToolRequest struct {
+	Name       string              `json:"name"`
+	Definition *BaseToolDefinition `json:"definition"`
+}
+
+// ToolPage is a single page of tools returned by ListTools
+type ToolPage struct {
+	Results  []StoredTool `json:"results"`
+	Next     string       `json:"next,omitempty"`
+	Previous string       `json:"previous,omitempty"`
+	Total    int          `json:"total,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *ToolPage) NextCursor() string {
+	return cursorFromURL(p.Next)
+}
+
+// ListTools returns a page of the tools saved in the account
+func (c *Client) ListTools(ctx context.Context, opts ...ListOption) (*ToolPage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_tools")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, buildListURL("/tools", opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page ToolPage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetTool retrieves a saved tool by its ID
+func (c *Client) GetTool(ctx context.Context, toolID string) (*StoredTool, error) {
+	if toolID == "" {
+		return nil, fmt.Errorf("tool ID is required")
+	}
+	return c.sendTool(ctx, "ultravox.get_tool", http.MethodGet, toolID, nil)
+}
+
+// CreateTool saves def as a new tool named after its ModelToolName
+func (c *Client) CreateTool(ctx context.Context, def *BaseToolDefinition) (*StoredTool, error) {
+	if def == nil {
+		return nil, fmt.Errorf("tool definition is required")
+	}
+	if err := def.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid tool: %w", err)
+	}
+	return c.sendTool(ctx, "ultravox.create_tool", http.MethodPost, "", &storedToolRequest{Name: def.ModelToolName, Definition: def})
+}
+
+// UpdateTool replaces the definition of a saved tool and returns the updated tool
+func (c *Client) UpdateTool(ctx context.Context, toolID string, def *BaseToolDefinition) (*StoredTool, error) {
+	if toolID == "" {
+		return nil, fmt.Errorf("tool ID is required")
+	}
+	if def == nil {
+		return nil, fmt.Errorf("tool definition is required")
+	}
+	if err := def.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid tool: %w", err)
+	}
+	return c.sendTool(ctx, "ultravox.update_tool", http.MethodPut, toolID, &storedToolRequest{Name: def.ModelToolName, Definition: def})
+}
+
+// DeleteTool deletes a saved tool by its ID
+func (c *Client) DeleteTool(ctx context.Context, toolID string) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+	if toolID == "" {
+		return fmt.Errorf("tool ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.delete_tool", attribute.String("tool.id", toolID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodDelete, "/tools/"+url.PathEscape(toolID), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// sendTool performs a request against the tools collection, or a single tool
+// when toolID is set, and decodes the tool returned by the API
+func (c *Client) sendTool(ctx context.Context, spanName, method, toolID string, body interface{}) (*StoredTool, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, spanName, attribute.String("tool.id", toolID))
+	defer span.End()
+
+	path := "/tools"
+	if toolID != "" {
+		path += "/" + url.PathEscape(toolID)
+	}
+
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var tool StoredTool
+	if err := c.do(req, &tool); err != nil {
+		return nil, err
+	}
+
+	return &tool, nil
+}
@@ -0,0 +1,180 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const storedToolResponse = `{
+	"toolId": "tool-123",
+	"name": "lookupOrder",
+	"created": "2024-01-02T03:04:05Z",
+	"definition": {
+		"modelToolName": "lookupOrder",
+		"description": "Look up an order by its number",
+		"http": {"baseUrlPattern": "https://example.com/orders", "httpMethod": "GET"}
+	}
+}`
+
+func lookupOrderDefinition() *ultravox.BaseToolDefinition {
+	return &ultravox.BaseToolDefinition{
+		ModelToolName: "lookupOrder",
+		Description:   "Look up an order by its number",
+		HTTP: &ultravox.BaseHTTPToolDetails{
+			BaseURLPattern: "https://example.com/orders",
+			HTTPMethod:     http.MethodGet,
+		},
+	}
+}
+
+func TestClient_ListTools(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/tools", req.URL.Path)
+			assert.Equal(t, "abc", req.URL.Query().Get("cursor"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"results": [` + storedToolResponse + `],
+					"next": "https://api.ultravox.ai/api/tools?cursor=def",
+					"total": 2
+				}`)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	page, err := client.ListTools(context.Background(), ultravox.WithCursor("abc"))
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "tool-123", page.Results[0].ToolID)
+	assert.Equal(t, "lookupOrder", page.Results[0].Definition.ModelToolName)
+	assert.Equal(t, 2, page.Total)
+	assert.Equal(t, "def", page.NextCursor())
+}
+
+func TestClient_GetTool(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNotFound} {
+		mockClient := &MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodGet, req.Method)
+				assert.Equal(t, "https://api.ultravox.ai/api/tools/tool-123", req.URL.String())
+
+				body := storedToolResponse
+				if status == http.StatusNotFound {
+					body = `{"detail": "Not found."}`
+				}
+				return &http.Response{
+					StatusCode: status,
+					Body:       io.NopCloser(bytes.NewBufferString(body)),
+				}, nil
+			},
+		}
+
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(mockClient)
+
+		tool, err := client.GetTool(context.Background(), "tool-123")
+		if status == http.StatusNotFound {
+			assert.ErrorIs(t, err, ultravox.ErrNotFound)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "lookupOrder", tool.Name)
+		assert.Equal(t, "https://example.com/orders", tool.Definition.HTTP.BaseURLPattern)
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	_, err := client.GetTool(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestClient_CreateTool(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/tools", req.URL.String())
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "lookupOrder", body["name"])
+			definition := body["definition"].(map[string]interface{})
+			assert.Equal(t, "Look up an order by its number", definition["description"])
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(storedToolResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	tool, err := client.CreateTool(context.Background(), lookupOrderDefinition())
+	require.NoError(t, err)
+	assert.Equal(t, "tool-123", tool.ToolID)
+
+	_, err = client.CreateTool(context.Background(), &ultravox.BaseToolDefinition{})
+	assert.Error(t, err)
+}
+
+func TestClient_UpdateTool(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPut, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/tools/tool-123", req.URL.String())
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, "lookupOrder", body["name"])
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(storedToolResponse)),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	tool, err := client.UpdateTool(context.Background(), "tool-123", lookupOrderDefinition())
+	require.NoError(t, err)
+	assert.Equal(t, "tool-123", tool.ToolID)
+
+	_, err = client.UpdateTool(context.Background(), "", lookupOrderDefinition())
+	assert.Error(t, err)
+}
+
+func TestClient_DeleteTool(t *testing.T) {
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/tools/tool-123", req.URL.String())
+
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(bytes.NewBufferString("")),
+			}, nil
+		},
+	}
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(mockClient)
+
+	require.NoError(t, client.DeleteTool(context.Background(), "tool-123"))
+	assert.Error(t, client.DeleteTool(context.Background(), ""))
+}
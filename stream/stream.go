@@ -0,0 +1,115 @@
+// Package stream provides channel-based helpers on top of ultravox call sessions.
+package stream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/paulgrammer/ultravox"
+)
+
+// transcriptRoles maps the roles used in WebSocket transcript events to message roles
+var transcriptRoles = map[string]ultravox.MessageRole{
+	"user":  ultravox.MessageRoleUser,
+	"agent": ultravox.MessageRoleAgent,
+}
+
+// StreamTranscripts joins the call at joinURL and delivers each finished
+// utterance as a Message. Partial transcripts are assembled internally and
+// only final segments are sent. The error channel receives at most one value,
+// the error that ended the stream, and both channels are closed when the call
+// ends or ctx is cancelled. A normal close by the server is not an error.
+func StreamTranscripts(ctx context.Context, joinURL string) (<-chan ultravox.Message, <-chan error) {
+	messages := make(chan ultravox.Message)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(messages)
+		defer close(errs)
+
+		session, err := ultravox.NewClient().Join(ctx, &ultravox.Call{JoinURL: joinURL})
+		if err != nil {
+			errs <- err
+			return
+		}
+		defer session.Close()
+
+		// Agent audio is not needed here, but it must be drained so the
+		// session keeps reading data messages
+		go func() {
+			for {
+				if _, err := session.ReadAudio(); err != nil {
+					return
+				}
+			}
+		}()
+
+		partial := make(map[int]*strings.Builder)
+		for {
+			ev, err := session.ReadEvent()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					errs <- err
+				}
+				return
+			}
+
+			transcript, ok := ev.(*ultravox.TranscriptEvent)
+			if !ok {
+				continue
+			}
+
+			text := assemble(partial, transcript)
+			if !transcript.Final {
+				continue
+			}
+
+			msg := ultravox.Message{
+				Role:   roleOf(transcript.Role),
+				Text:   text,
+				Medium: transcript.Medium,
+			}
+			select {
+			case messages <- msg:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return messages, errs
+}
+
+// assemble accumulates the text of a transcript by ordinal, returning the text
+// so far and forgetting the utterance once it is final. Events carry either the
+// full text or a delta to append.
+func assemble(partial map[int]*strings.Builder, ev *ultravox.TranscriptEvent) string {
+	b, ok := partial[ev.Ordinal]
+	if !ok {
+		b = &strings.Builder{}
+		partial[ev.Ordinal] = b
+	}
+
+	if ev.Text != "" {
+		b.Reset()
+		b.WriteString(ev.Text)
+	} else {
+		b.WriteString(ev.Delta)
+	}
+
+	if ev.Final {
+		delete(partial, ev.Ordinal)
+	}
+	return b.String()
+}
+
+// roleOf converts a transcript role to its message role, keeping unknown roles as-is
+func roleOf(role string) string {
+	if r, ok := transcriptRoles[role]; ok {
+		return string(r)
+	}
+	return role
+}
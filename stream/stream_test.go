@@ -0,0 +1,112 @@
+package stream_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/paulgrammer/ultravox"
+	"github.com/paulgrammer/ultravox/stream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCallServer starts a WebSocket server running handler for each connection and returns its join URL
+func newCallServer(t *testing.T, handler func(conn *websocket.Conn)) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+// collect drains both channels, failing the test if they stay open too long
+func collect(t *testing.T, messages <-chan ultravox.Message, errs <-chan error) ([]ultravox.Message, error) {
+	t.Helper()
+
+	var got []ultravox.Message
+	timeout := time.After(5 * time.Second)
+	for messages != nil {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			got = append(got, msg)
+		case <-timeout:
+			t.Fatal("transcript stream did not close")
+		}
+	}
+
+	var err error
+	for e := range errs {
+		require.NoError(t, err, "more than one error delivered")
+		err = e
+	}
+	return got, err
+}
+
+func TestStreamTranscripts(t *testing.T) {
+	t.Run("Delivers final transcripts", func(t *testing.T) {
+		joinURL := newCallServer(t, func(conn *websocket.Conn) {
+			for _, msg := range []string{
+				`{"type":"state","state":"speaking"}`,
+				`{"type":"transcript","role":"agent","medium":"voice","delta":"Hello, ","final":false,"ordinal":0}`,
+				`{"type":"transcript","role":"agent","medium":"voice","delta":"how can I help?","final":false,"ordinal":0}`,
+				`{"type":"transcript","role":"agent","medium":"voice","final":true,"ordinal":0}`,
+				`{"type":"transcript","role":"user","medium":"voice","text":"What's the","final":false,"ordinal":1}`,
+				`{"type":"transcript","role":"user","medium":"voice","text":"What's the weather?","final":true,"ordinal":1}`,
+			} {
+				conn.WriteMessage(websocket.TextMessage, []byte(msg))
+			}
+			conn.WriteMessage(websocket.BinaryMessage, make([]byte, 320))
+			conn.WriteMessage(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			conn.ReadMessage()
+		})
+
+		messages, errs := stream.StreamTranscripts(context.Background(), joinURL)
+		got, err := collect(t, messages, errs)
+		require.NoError(t, err)
+
+		require.Len(t, got, 2)
+		assert.Equal(t, string(ultravox.MessageRoleAgent), got[0].Role)
+		assert.Equal(t, "Hello, how can I help?", got[0].Text)
+		assert.Equal(t, string(ultravox.MessageRoleUser), got[1].Role)
+		assert.Equal(t, "What's the weather?", got[1].Text)
+	})
+
+	t.Run("Reports dial failure", func(t *testing.T) {
+		messages, errs := stream.StreamTranscripts(context.Background(), "ws://127.0.0.1:1/join")
+		got, err := collect(t, messages, errs)
+		assert.Empty(t, got)
+		assert.Error(t, err)
+	})
+
+	t.Run("Stops on cancellation", func(t *testing.T) {
+		joinURL := newCallServer(t, func(conn *websocket.Conn) {
+			conn.ReadMessage()
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		messages, errs := stream.StreamTranscripts(ctx, joinURL)
+		cancel()
+
+		_, err := collect(t, messages, errs)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -1,5 +1,13 @@
 package ultravox
 
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+)
+
 // SelectedTool represents a tool selected for a particular call
 type SelectedTool struct {
 	ToolID              string                 `json:"toolId,omitempty" yaml:"toolId,omitempty"`
@@ -12,6 +20,81 @@ type SelectedTool struct {
 	TransitionID        string                 `json:"transitionId,omitempty" yaml:"transitionId,omitempty"`
 }
 
+// cloneSelectedTools returns a deep copy of tools. Parameter override values
+// and parameter schemas are shared.
+func cloneSelectedTools(tools []SelectedTool) []SelectedTool {
+	if tools == nil {
+		return nil
+	}
+	c := make([]SelectedTool, len(tools))
+	for i, tool := range tools {
+		tool.TemporaryTool = tool.TemporaryTool.clone()
+		tool.AuthTokens = maps.Clone(tool.AuthTokens)
+		tool.ParameterOverrides = maps.Clone(tool.ParameterOverrides)
+		c[i] = tool
+	}
+	return c
+}
+
+// hasName reports whether the tool is selected by name, under an override
+// or as a temporary tool called name
+func (t SelectedTool) hasName(name string) bool {
+	if t.ToolName == name || t.NameOverride == name {
+		return true
+	}
+	return t.TemporaryTool != nil && t.TemporaryTool.ModelToolName == name
+}
+
+// SelectedToolBuilder configures a tool selection with per-call parameter
+// overrides and auth tokens before it is added to a call
+type SelectedToolBuilder struct {
+	tool SelectedTool
+}
+
+// SelectToolByID starts a selection of the saved tool with the given ID
+func SelectToolByID(toolID string) *SelectedToolBuilder {
+	return &SelectedToolBuilder{tool: SelectedTool{ToolID: toolID}}
+}
+
+// SelectToolByName starts a selection of the saved tool with the given name
+func SelectToolByName(toolName string) *SelectedToolBuilder {
+	return &SelectedToolBuilder{tool: SelectedTool{ToolName: toolName}}
+}
+
+// OverrideParam fixes the value of a tool parameter for this call
+func (b *SelectedToolBuilder) OverrideParam(name string, value interface{}) *SelectedToolBuilder {
+	if b.tool.ParameterOverrides == nil {
+		b.tool.ParameterOverrides = make(map[string]interface{})
+	}
+	b.tool.ParameterOverrides[name] = value
+	return b
+}
+
+// SetAuthToken supplies the token for one of the tool's security requirements
+func (b *SelectedToolBuilder) SetAuthToken(key, token string) *SelectedToolBuilder {
+	if b.tool.AuthTokens == nil {
+		b.tool.AuthTokens = make(map[string]string)
+	}
+	b.tool.AuthTokens[key] = token
+	return b
+}
+
+// Tool returns the configured selection
+func (b *SelectedToolBuilder) Tool() SelectedTool {
+	tool := b.tool
+	tool.ParameterOverrides = maps.Clone(b.tool.ParameterOverrides)
+	tool.AuthTokens = maps.Clone(b.tool.AuthTokens)
+	return tool
+}
+
+// Option returns a call option that adds the configured selection to the call
+func (b *SelectedToolBuilder) Option() CallOption {
+	tool := b.Tool()
+	return func(r *CallRequest) {
+		r.SelectedTools = append(r.SelectedTools, tool)
+	}
+}
+
 // BaseToolDefinition defines a tool that can be used during a call
 type BaseToolDefinition struct {
 	ModelToolName       string                         `json:"modelToolName" yaml:"modelToolName"`
@@ -29,6 +112,57 @@ type BaseToolDefinition struct {
 	StaticResponse      *StaticToolResponse            `json:"staticResponse,omitempty" yaml:"staticResponse,omitempty"`
 }
 
+// clone returns a deep copy of the definition
+func (d *BaseToolDefinition) clone() *BaseToolDefinition {
+	if d == nil {
+		return nil
+	}
+	c := *d
+	c.DynamicParameters = slices.Clone(d.DynamicParameters)
+	c.StaticParameters = slices.Clone(d.StaticParameters)
+	c.AutomaticParameters = slices.Clone(d.AutomaticParameters)
+	c.Requirements = d.Requirements.clone()
+	c.HTTP = clonePtr(d.HTTP)
+	c.Client = clonePtr(d.Client)
+	c.DataConnection = clonePtr(d.DataConnection)
+	c.StaticResponse = clonePtr(d.StaticResponse)
+	return &c
+}
+
+// Validate checks that the tool has a name and exactly one implementation,
+// and that an HTTP tool has a URL and a supported method
+func (d *BaseToolDefinition) Validate() error {
+	var errs []error
+	if d.ModelToolName == "" {
+		errs = append(errs, errors.New("modelToolName is required"))
+	}
+	if n := d.implementationCount(); n != 1 {
+		errs = append(errs, fmt.Errorf("exactly one of http, client or dataConnection must be set, got %d", n))
+	}
+	if d.HTTP != nil {
+		if d.HTTP.BaseURLPattern == "" {
+			errs = append(errs, errors.New("http.baseUrlPattern is required"))
+		}
+		switch d.HTTP.HTTPMethod {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		default:
+			errs = append(errs, fmt.Errorf("http.httpMethod %q is not supported", d.HTTP.HTTPMethod))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// implementationCount returns how many of HTTP, Client and DataConnection are set
+func (d *BaseToolDefinition) implementationCount() int {
+	n := 0
+	for _, set := range []bool{d.HTTP != nil, d.Client != nil, d.DataConnection != nil} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
+
 // DynamicParameter represents a parameter that can be set by the model
 type DynamicParameter struct {
 	Name     string            `json:"name" yaml:"name"`
@@ -57,6 +191,33 @@ type ToolRequirements struct {
 	RequiredParameterOverrides []string         `json:"requiredParameterOverrides,omitempty" yaml:"requiredParameterOverrides,omitempty"`
 }
 
+// clone returns a deep copy of the requirements
+func (r *ToolRequirements) clone() *ToolRequirements {
+	if r == nil {
+		return nil
+	}
+	c := ToolRequirements{RequiredParameterOverrides: slices.Clone(r.RequiredParameterOverrides)}
+	if r.HTTPSecurityOptions != nil {
+		c.HTTPSecurityOptions = &SecurityOptions{Options: make([]SecurityRequirements, len(r.HTTPSecurityOptions.Options))}
+		for i, option := range r.HTTPSecurityOptions.Options {
+			option.Requirements = maps.Clone(option.Requirements)
+			for key, requirement := range option.Requirements {
+				requirement.QueryAPIKey = clonePtr(requirement.QueryAPIKey)
+				requirement.HeaderAPIKey = clonePtr(requirement.HeaderAPIKey)
+				requirement.HTTPAuth = clonePtr(requirement.HTTPAuth)
+				option.Requirements[key] = requirement
+			}
+			if option.UltravoxCallTokenRequirement != nil {
+				option.UltravoxCallTokenRequirement = &UltravoxCallTokenRequirement{
+					Scopes: slices.Clone(option.UltravoxCallTokenRequirement.Scopes),
+				}
+			}
+			c.HTTPSecurityOptions.Options[i] = option
+		}
+	}
+	return &c
+}
+
 // SecurityOptions defines different security requirement options
 type SecurityOptions struct {
 	Options []SecurityRequirements `json:"options" yaml:"options"`
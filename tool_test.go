@@ -0,0 +1,262 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHTTPToolWithAuth(t *testing.T) {
+	tests := []struct {
+		name             string
+		tool             *ultravox.BaseToolDefinition
+		wantRequirements string
+	}{
+		{
+			name:             "Bearer",
+			tool:             ultravox.NewHTTPToolWithBearerAuth("lookupOrder", "Look up an order", "https://api.example.com/orders/{id}", http.MethodGet),
+			wantRequirements: `{"httpSecurityOptions": {"options": [{"requirements": {"Authorization": {"httpAuth": {"scheme": "Bearer"}}}}]}}`,
+		},
+		{
+			name:             "Header API key",
+			tool:             ultravox.NewHTTPToolWithAPIKeyHeader("lookupOrder", "Look up an order", "https://api.example.com/orders/{id}", http.MethodGet, "X-API-Key"),
+			wantRequirements: `{"httpSecurityOptions": {"options": [{"requirements": {"X-API-Key": {"headerApiKey": {"name": "X-API-Key"}}}}]}}`,
+		},
+		{
+			name:             "Query API key",
+			tool:             ultravox.NewHTTPToolWithQueryAPIKey("lookupOrder", "Look up an order", "https://api.example.com/orders/{id}", http.MethodGet, "api_key"),
+			wantRequirements: `{"httpSecurityOptions": {"options": [{"requirements": {"api_key": {"queryApiKey": {"name": "api_key"}}}}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, "lookupOrder", tt.tool.ModelToolName)
+			assert.Equal(t, "Look up an order", tt.tool.Description)
+			assert.Equal(t, &ultravox.BaseHTTPToolDetails{
+				BaseURLPattern: "https://api.example.com/orders/{id}",
+				HTTPMethod:     http.MethodGet,
+			}, tt.tool.HTTP)
+
+			data, err := json.Marshal(tt.tool.Requirements)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantRequirements, string(data))
+		})
+	}
+}
+
+func TestSelectToolByID(t *testing.T) {
+	builder := ultravox.SelectToolByID("tool-123").
+		OverrideParam("units", "metric").
+		OverrideParam("days", 3).
+		SetAuthToken("X-API-Key", "secret")
+
+	var request ultravox.CallRequest
+	ultravox.WithCallToolByName("hangUp")(&request)
+	builder.Option()(&request)
+
+	require.Len(t, request.SelectedTools, 2)
+	data, err := json.Marshal(request.SelectedTools[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"toolId": "tool-123",
+		"parameterOverrides": {"units": "metric", "days": 3},
+		"authTokens": {"X-API-Key": "secret"}
+	}`, string(data))
+
+	t.Run("Options are independent of later changes", func(t *testing.T) {
+		opt := ultravox.SelectToolByName("weather").OverrideParam("units", "metric")
+		selection := opt.Option()
+		opt.OverrideParam("units", "imperial")
+
+		var request ultravox.CallRequest
+		selection(&request)
+		assert.Equal(t, "metric", request.SelectedTools[0].ParameterOverrides["units"])
+	})
+}
+
+func TestWithCallSelectedTools(t *testing.T) {
+	tools := []ultravox.SelectedTool{
+		{ToolName: "lookupOrder"},
+		{ToolID: "tool-123", NameOverride: "weather"},
+		{TemporaryTool: ultravox.NewClientTool("checkout", "Start the checkout")},
+	}
+
+	var request ultravox.CallRequest
+	ultravox.WithCallToolByName("hangUp")(&request)
+	ultravox.WithCallSelectedTools(tools)(&request)
+	ultravox.WithCallToolAuthToken("lookupOrder", "Authorization", "Bearer secret")(&request)
+	ultravox.WithCallToolAuthToken("weather", "X-API-Key", "key")(&request)
+	ultravox.WithCallToolAuthToken("checkout", "X-API-Key", "other")(&request)
+	require.NoError(t, request.Validate())
+
+	require.Len(t, request.SelectedTools, 3, "the existing tools are replaced")
+	assert.Equal(t, map[string]string{"Authorization": "Bearer secret"}, request.SelectedTools[0].AuthTokens)
+	assert.Equal(t, map[string]string{"X-API-Key": "key"}, request.SelectedTools[1].AuthTokens)
+	assert.Equal(t, map[string]string{"X-API-Key": "other"}, request.SelectedTools[2].AuthTokens)
+	assert.Nil(t, tools[0].AuthTokens, "the caller's tools are not modified")
+
+	t.Run("Unknown tool", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		_, err := client.Call(context.Background(),
+			ultravox.WithCallToolByName("hangUp"),
+			ultravox.WithCallToolAuthToken("lookupOrder", "Authorization", "Bearer secret"),
+		)
+		assert.ErrorContains(t, err, `tool "lookupOrder" is not selected`)
+	})
+}
+
+// newDefaultToolClient returns a client whose call defaults select lookup, and
+// the bodies of the call requests it sends
+func newDefaultToolClient(t *testing.T) (*ultravox.Client, *[]map[string]interface{}) {
+	t.Helper()
+
+	var bodies []map[string]interface{}
+	client := ultravox.NewClient(
+		ultravox.WithAPIKey("test-api-key"),
+		func(c *ultravox.Config) { ultravox.WithCallToolByName("lookup")(&c.CallRequest) },
+	)
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			bodies = append(bodies, body)
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+			}, nil
+		},
+	})
+	return client, &bodies
+}
+
+func TestWithCallToolAuthToken_DoesNotLeakIntoDefaults(t *testing.T) {
+	client, bodies := newDefaultToolClient(t)
+
+	_, err := client.Call(context.Background(), ultravox.WithCallToolAuthToken("lookup", "k", "tenantA-secret"))
+	require.NoError(t, err)
+	_, err = client.Call(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, *bodies, 2)
+	assert.Equal(t, map[string]interface{}{"k": "tenantA-secret"}, (*bodies)[0]["selectedTools"].([]interface{})[0].(map[string]interface{})["authTokens"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"toolName": "lookup"}}, (*bodies)[1]["selectedTools"])
+}
+
+func TestWithCallToolParameterOverride(t *testing.T) {
+	t.Run("Selected tool", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.SelectToolByName("lookupStore").OverrideParam("units", "metric").Option()(&request)
+		ultravox.WithCallToolParameterOverride("lookupStore", "locationId", "loc-42")(&request)
+		require.NoError(t, request.Validate())
+
+		assert.Equal(t, map[string]interface{}{
+			"units":      "metric",
+			"locationId": "loc-42",
+		}, request.SelectedTools[0].ParameterOverrides)
+	})
+
+	t.Run("Does not leak into defaults", func(t *testing.T) {
+		client, bodies := newDefaultToolClient(t)
+
+		_, err := client.Call(context.Background(), ultravox.WithCallToolParameterOverride("lookup", "locationId", "loc-42"))
+		require.NoError(t, err)
+		_, err = client.Call(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, *bodies, 2)
+		assert.Equal(t, map[string]interface{}{"locationId": "loc-42"}, (*bodies)[0]["selectedTools"].([]interface{})[0].(map[string]interface{})["parameterOverrides"])
+		assert.Equal(t, []interface{}{map[string]interface{}{"toolName": "lookup"}}, (*bodies)[1]["selectedTools"])
+	})
+
+	t.Run("Missing tool", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		_, err := client.Call(context.Background(),
+			ultravox.WithCallToolByName("hangUp"),
+			ultravox.WithCallToolParameterOverride("lookupStore", "locationId", "loc-42"),
+		)
+		assert.ErrorContains(t, err, `cannot override parameter "locationId": tool "lookupStore" is not selected`)
+	})
+}
+
+func TestParameterLocationHelpers(t *testing.T) {
+	schema := ultravox.Schema().String()
+
+	assert.Equal(t, ultravox.NewDynamicParameter("q", ultravox.ParameterLocationQuery, schema, true), ultravox.NewQueryParameter("q", schema, true))
+	assert.Equal(t, ultravox.NewDynamicParameter("id", ultravox.ParameterLocationPath, schema, true), ultravox.NewPathParameter("id", schema, true))
+	assert.Equal(t, ultravox.NewDynamicParameter("X-Trace", ultravox.ParameterLocationHeader, schema, false), ultravox.NewHeaderParameter("X-Trace", schema, false))
+	assert.Equal(t, ultravox.NewDynamicParameter("note", ultravox.ParameterLocationBody, schema, false), ultravox.NewBodyParameter("note", schema, false))
+
+	assert.Equal(t, ultravox.NewStaticParameter("units", ultravox.ParameterLocationQuery, "metric"), ultravox.NewStaticQueryParameter("units", "metric"))
+	assert.Equal(t, ultravox.NewStaticParameter("v", ultravox.ParameterLocationPath, "2"), ultravox.NewStaticPathParameter("v", "2"))
+	assert.Equal(t, ultravox.NewStaticParameter("X-Source", ultravox.ParameterLocationHeader, "voice"), ultravox.NewStaticHeaderParameter("X-Source", "voice"))
+	assert.Equal(t, ultravox.NewStaticParameter("channel", ultravox.ParameterLocationBody, "phone"), ultravox.NewStaticBodyParameter("channel", "phone"))
+
+	assert.Equal(t, ultravox.NewAutomaticParameter("call", ultravox.ParameterLocationQuery, ultravox.KnownParamCallID), ultravox.NewAutomaticQueryParameter("call", ultravox.KnownParamCallID))
+	assert.Equal(t, ultravox.NewAutomaticParameter("call", ultravox.ParameterLocationPath, ultravox.KnownParamCallID), ultravox.NewAutomaticPathParameter("call", ultravox.KnownParamCallID))
+	assert.Equal(t, ultravox.NewAutomaticParameter("X-Call", ultravox.ParameterLocationHeader, ultravox.KnownParamCallID), ultravox.NewAutomaticHeaderParameter("X-Call", ultravox.KnownParamCallID))
+	assert.Equal(t, ultravox.NewAutomaticParameter("history", ultravox.ParameterLocationBody, ultravox.KnownParamConversationHistory), ultravox.NewAutomaticBodyParameter("history", ultravox.KnownParamConversationHistory))
+}
+
+func TestBaseToolDefinition_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		tool    *ultravox.BaseToolDefinition
+		wantErr string
+	}{
+		{
+			name: "HTTP tool",
+			tool: ultravox.NewHTTPTool("lookupOrder", "Look up an order", "https://api.example.com/orders", http.MethodGet),
+		},
+		{
+			name: "Client tool",
+			tool: ultravox.NewClientTool("showMap", "Show a map"),
+		},
+		{
+			name:    "No implementation",
+			tool:    &ultravox.BaseToolDefinition{ModelToolName: "lookupOrder"},
+			wantErr: "exactly one of http, client or dataConnection must be set, got 0",
+		},
+		{
+			name: "Two implementations",
+			tool: &ultravox.BaseToolDefinition{
+				ModelToolName: "lookupOrder",
+				HTTP:          &ultravox.BaseHTTPToolDetails{BaseURLPattern: "https://api.example.com", HTTPMethod: http.MethodGet},
+				Client:        &ultravox.BaseClientToolDetails{},
+			},
+			wantErr: "exactly one of http, client or dataConnection must be set, got 2",
+		},
+		{
+			name:    "Missing name",
+			tool:    ultravox.NewClientTool("", "Show a map"),
+			wantErr: "modelToolName is required",
+		},
+		{
+			name:    "Unsupported method",
+			tool:    ultravox.NewHTTPTool("lookupOrder", "Look up an order", "https://api.example.com/orders", "get"),
+			wantErr: `http.httpMethod "get" is not supported`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tool.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			var request ultravox.CallRequest
+			ultravox.WithCallTemporaryTool(tt.tool)(&request)
+			assert.ErrorContains(t, request.Validate(), "selectedTools[0]: temporaryTool: "+tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package ultravox
+
+import (
+	"fmt"
+	"time"
+)
+
+// ToolBuilder is a chainable alternative to filling in a BaseToolDefinition by
+// hand. Build checks the definition before returning it.
+type ToolBuilder struct {
+	def BaseToolDefinition
+}
+
+// NewToolBuilder starts a tool known to the model as name
+func NewToolBuilder(name, description string) *ToolBuilder {
+	return &ToolBuilder{def: BaseToolDefinition{ModelToolName: name, Description: description}}
+}
+
+// HTTP makes the tool call baseURL with method
+func (b *ToolBuilder) HTTP(baseURL, method string) *ToolBuilder {
+	b.def.HTTP = &BaseHTTPToolDetails{BaseURLPattern: baseURL, HTTPMethod: method}
+	return b
+}
+
+// Client makes the tool run on the client that joined the call
+func (b *ToolBuilder) Client() *ToolBuilder {
+	b.def.Client = &BaseClientToolDetails{}
+	return b
+}
+
+// DataConnection makes the tool run over the call's data connection
+func (b *ToolBuilder) DataConnection() *ToolBuilder {
+	b.def.DataConnection = &BaseDataConnectionToolDetails{}
+	return b
+}
+
+// AddDynamicParam adds a parameter whose value the model chooses
+func (b *ToolBuilder) AddDynamicParam(param DynamicParameter) *ToolBuilder {
+	b.def.DynamicParameters = append(b.def.DynamicParameters, param)
+	return b
+}
+
+// AddStaticParam adds a parameter with a fixed value
+func (b *ToolBuilder) AddStaticParam(param StaticParameter) *ToolBuilder {
+	b.def.StaticParameters = append(b.def.StaticParameters, param)
+	return b
+}
+
+// AddAutomaticParam adds a parameter filled in by Ultravox, such as the call ID
+func (b *ToolBuilder) AddAutomaticParam(param AutomaticParameter) *ToolBuilder {
+	b.def.AutomaticParameters = append(b.def.AutomaticParameters, param)
+	return b
+}
+
+// Timeout sets how long Ultravox waits for the tool to respond
+func (b *ToolBuilder) Timeout(d time.Duration) *ToolBuilder {
+	b.def.Timeout = UltravoxDuration(d)
+	return b
+}
+
+// Precomputable marks the tool as safe to call before the model asks for it
+func (b *ToolBuilder) Precomputable(precomputable bool) *ToolBuilder {
+	b.def.Precomputable = precomputable
+	return b
+}
+
+// StaticResponse makes the tool answer with text without being invoked
+func (b *ToolBuilder) StaticResponse(text string) *ToolBuilder {
+	b.def.StaticResponse = &StaticToolResponse{ResponseText: text}
+	return b
+}
+
+// Build returns a copy of the definition after checking it with
+// BaseToolDefinition.Validate, so that it has a name and exactly one of HTTP,
+// Client or DataConnection
+func (b *ToolBuilder) Build() (*BaseToolDefinition, error) {
+	if err := b.def.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid tool %q: %w", b.def.ModelToolName, err)
+	}
+	return b.def.clone(), nil
+}
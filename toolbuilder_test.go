@@ -0,0 +1,82 @@
+package ultravox_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolBuilder(t *testing.T) {
+	builder := ultravox.NewToolBuilder("lookupOrder", "Look up an order").
+		HTTP("https://api.example.com/orders/{id}", http.MethodGet).
+		AddDynamicParam(ultravox.NewPathParameter("id", ultravox.Schema().String(), true)).
+		AddStaticParam(ultravox.NewStaticQueryParameter("units", "metric")).
+		AddAutomaticParam(ultravox.NewAutomaticHeaderParameter("X-Call", ultravox.KnownParamCallID)).
+		Timeout(5 * time.Second).
+		Precomputable(true)
+
+	tool, err := builder.Build()
+	require.NoError(t, err)
+
+	data, err := json.Marshal(tool)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"modelToolName": "lookupOrder",
+		"description": "Look up an order",
+		"http": {"baseUrlPattern": "https://api.example.com/orders/{id}", "httpMethod": "GET"},
+		"dynamicParameters": [{"name": "id", "location": "PARAMETER_LOCATION_PATH", "schema": {"type": "string"}, "required": true}],
+		"staticParameters": [{"name": "units", "location": "PARAMETER_LOCATION_QUERY", "value": "metric"}],
+		"automaticParameters": [{"name": "X-Call", "location": "PARAMETER_LOCATION_HEADER", "knownValue": "KNOWN_PARAM_CALL_ID"}],
+		"timeout": "5s",
+		"precomputable": true
+	}`, string(data))
+
+	t.Run("Built tools are independent of the builder", func(t *testing.T) {
+		builder.AddStaticParam(ultravox.NewStaticQueryParameter("lang", "en"))
+		assert.Len(t, tool.StaticParameters, 1)
+	})
+
+	t.Run("Client tool with static response", func(t *testing.T) {
+		tool, err := ultravox.NewToolBuilder("greet", "Greet the user").Client().StaticResponse("Hello!").Build()
+		require.NoError(t, err)
+		assert.NotNil(t, tool.Client)
+		assert.Equal(t, "Hello!", tool.StaticResponse.ResponseText)
+	})
+}
+
+func TestToolBuilder_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *ultravox.ToolBuilder
+		wantErr string
+	}{
+		{
+			name:    "No implementation",
+			builder: ultravox.NewToolBuilder("lookupOrder", "Look up an order"),
+			wantErr: "exactly one of http, client or dataConnection must be set, got 0",
+		},
+		{
+			name:    "HTTP and client",
+			builder: ultravox.NewToolBuilder("lookupOrder", "Look up an order").HTTP("https://api.example.com", http.MethodGet).Client(),
+			wantErr: "exactly one of http, client or dataConnection must be set, got 2",
+		},
+		{
+			name:    "Missing name",
+			builder: ultravox.NewToolBuilder("", "Look up an order").DataConnection(),
+			wantErr: "modelToolName is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tool, err := tt.builder.Build()
+			assert.Nil(t, tool)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,331 @@
+// Package tools builds ultravox tool definitions from external descriptions
+// of HTTP services.
+package tools
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/paulgrammer/ultravox"
+	"gopkg.in/yaml.v3"
+)
+
+// document is the subset of an OpenAPI 3.x document needed to build tools
+type document struct {
+	OpenAPI    string              `yaml:"openapi"`
+	Servers    []server            `yaml:"servers"`
+	Paths      map[string]pathItem `yaml:"paths"`
+	Components components          `yaml:"components"`
+}
+
+type server struct {
+	URL string `yaml:"url"`
+}
+
+type components struct {
+	Schemas       map[string]interface{} `yaml:"schemas"`
+	Parameters    map[string]parameter   `yaml:"parameters"`
+	RequestBodies map[string]requestBody `yaml:"requestBodies"`
+}
+
+// pathItem holds the operations available on a single path
+type pathItem struct {
+	Parameters []parameter `yaml:"parameters"`
+	Get        *operation  `yaml:"get"`
+	Put        *operation  `yaml:"put"`
+	Post       *operation  `yaml:"post"`
+	Delete     *operation  `yaml:"delete"`
+	Options    *operation  `yaml:"options"`
+	Head       *operation  `yaml:"head"`
+	Patch      *operation  `yaml:"patch"`
+	Trace      *operation  `yaml:"trace"`
+}
+
+// operations returns the operations defined on the path keyed by HTTP method,
+// in a fixed order
+func (p pathItem) operations() []methodOperation {
+	all := []methodOperation{
+		{"GET", p.Get}, {"PUT", p.Put}, {"POST", p.Post}, {"DELETE", p.Delete},
+		{"OPTIONS", p.Options}, {"HEAD", p.Head}, {"PATCH", p.Patch}, {"TRACE", p.Trace},
+	}
+	ops := all[:0]
+	for _, op := range all {
+		if op.operation != nil {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+type methodOperation struct {
+	method    string
+	operation *operation
+}
+
+type operation struct {
+	OperationID string       `yaml:"operationId"`
+	Summary     string       `yaml:"summary"`
+	Description string       `yaml:"description"`
+	Parameters  []parameter  `yaml:"parameters"`
+	RequestBody *requestBody `yaml:"requestBody"`
+}
+
+type parameter struct {
+	Ref      string      `yaml:"$ref"`
+	Name     string      `yaml:"name"`
+	In       string      `yaml:"in"`
+	Required bool        `yaml:"required"`
+	Schema   interface{} `yaml:"schema"`
+}
+
+type requestBody struct {
+	Ref     string               `yaml:"$ref"`
+	Content map[string]mediaType `yaml:"content"`
+}
+
+type mediaType struct {
+	Schema interface{} `yaml:"schema"`
+}
+
+// parameterLocations maps OpenAPI parameter locations to tool parameter locations
+var parameterLocations = map[string]ultravox.ParameterLocation{
+	"path":   ultravox.ParameterLocationPath,
+	"query":  ultravox.ParameterLocationQuery,
+	"header": ultravox.ParameterLocationHeader,
+}
+
+// ToolsFromOpenAPISpec parses an OpenAPI 3.x document in JSON or YAML form and
+// returns one HTTP tool per operation. The operationId becomes the tool name
+// and the summary, or the description if there is none, its description. The
+// first server URL is joined with each path to form the base URL pattern.
+// Path, query and header parameters keep their location, and the properties
+// of a JSON request body become body parameters. Local $ref references are
+// resolved; operations are returned sorted by path and then method.
+func ToolsFromOpenAPISpec(spec []byte) ([]*ultravox.BaseToolDefinition, error) {
+	var doc document
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec: %w", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.x", doc.OpenAPI)
+	}
+	if len(doc.Servers) == 0 || doc.Servers[0].URL == "" {
+		return nil, errors.New("OpenAPI spec has no server URL")
+	}
+	baseURL := strings.TrimSuffix(doc.Servers[0].URL, "/")
+
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var tools []*ultravox.BaseToolDefinition
+	for _, path := range paths {
+		item := doc.Paths[path]
+		for _, op := range item.operations() {
+			tool, err := doc.buildTool(baseURL+path, op.method, item.Parameters, op.operation)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", op.method, path, err)
+			}
+			tools = append(tools, tool)
+		}
+	}
+
+	return tools, nil
+}
+
+// buildTool converts a single operation into a tool definition
+func (d *document) buildTool(urlPattern, method string, shared []parameter, op *operation) (*ultravox.BaseToolDefinition, error) {
+	if op.OperationID == "" {
+		return nil, errors.New("operationId is required")
+	}
+
+	description := op.Summary
+	if description == "" {
+		description = op.Description
+	}
+	tool := ultravox.NewHTTPTool(op.OperationID, description, urlPattern, method)
+
+	params, err := d.mergeParameters(shared, op.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range params {
+		location, ok := parameterLocations[p.In]
+		if !ok {
+			return nil, fmt.Errorf("parameter %q: unsupported location %q", p.Name, p.In)
+		}
+		schema, err := d.resolveSchema(p.Schema, nil)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", p.Name, err)
+		}
+		required := p.Required || location == ultravox.ParameterLocationPath
+		tool.DynamicParameters = append(tool.DynamicParameters,
+			ultravox.NewDynamicParameter(p.Name, location, schema, required))
+	}
+
+	if op.RequestBody != nil {
+		bodyParams, err := d.bodyParameters(op.RequestBody)
+		if err != nil {
+			return nil, fmt.Errorf("request body: %w", err)
+		}
+		tool.DynamicParameters = append(tool.DynamicParameters, bodyParams...)
+	}
+
+	return tool, nil
+}
+
+// mergeParameters resolves the path-level and operation-level parameters,
+// letting the operation override a path-level parameter with the same name
+// and location
+func (d *document) mergeParameters(shared, own []parameter) ([]parameter, error) {
+	var merged []parameter
+	index := make(map[string]int)
+	for _, list := range [][]parameter{shared, own} {
+		for _, p := range list {
+			resolved, err := d.resolveParameter(p)
+			if err != nil {
+				return nil, err
+			}
+			key := resolved.In + ":" + resolved.Name
+			if i, ok := index[key]; ok {
+				merged[i] = resolved
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, resolved)
+		}
+	}
+	return merged, nil
+}
+
+// bodyParameters returns one body parameter for each property of the JSON
+// request body schema
+func (d *document) bodyParameters(body *requestBody) ([]ultravox.DynamicParameter, error) {
+	if body.Ref != "" {
+		name, err := componentName(body.Ref, "requestBodies")
+		if err != nil {
+			return nil, err
+		}
+		resolved, ok := d.Components.RequestBodies[name]
+		if !ok {
+			return nil, fmt.Errorf("unresolved reference %q", body.Ref)
+		}
+		body = &resolved
+	}
+
+	media, ok := body.Content["application/json"]
+	if !ok {
+		return nil, errors.New("only application/json request bodies are supported")
+	}
+	resolved, err := d.resolveSchema(media.Schema, nil)
+	if err != nil {
+		return nil, err
+	}
+	schema, ok := resolved.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("schema must be an object")
+	}
+	properties, _ := schema["properties"].(map[string]interface{})
+
+	required := make(map[string]bool)
+	if list, ok := schema["required"].([]interface{}); ok {
+		for _, name := range list {
+			if s, ok := name.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]ultravox.DynamicParameter, 0, len(names))
+	for _, name := range names {
+		params = append(params, ultravox.NewDynamicParameter(
+			name, ultravox.ParameterLocationBody, properties[name], required[name]))
+	}
+	return params, nil
+}
+
+// resolveParameter follows a $ref to a shared component parameter
+func (d *document) resolveParameter(p parameter) (parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+	name, err := componentName(p.Ref, "parameters")
+	if err != nil {
+		return parameter{}, err
+	}
+	resolved, ok := d.Components.Parameters[name]
+	if !ok {
+		return parameter{}, fmt.Errorf("unresolved reference %q", p.Ref)
+	}
+	return resolved, nil
+}
+
+// resolveSchema returns a copy of schema with every $ref to a component schema
+// replaced by its definition. seen holds the references being expanded so
+// that recursive schemas are reported instead of looping forever.
+func (d *document) resolveSchema(schema interface{}, seen []string) (interface{}, error) {
+	switch v := schema.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			for _, s := range seen {
+				if s == ref {
+					return nil, fmt.Errorf("recursive schema reference %q", ref)
+				}
+			}
+			name, err := componentName(ref, "schemas")
+			if err != nil {
+				return nil, err
+			}
+			target, ok := d.Components.Schemas[name]
+			if !ok {
+				return nil, fmt.Errorf("unresolved reference %q", ref)
+			}
+			return d.resolveSchema(target, append(seen, ref))
+		}
+
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			resolved, err := d.resolveSchema(value, seen)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			resolved, err := d.resolveSchema(value, seen)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+
+	default:
+		return v, nil
+	}
+}
+
+// componentName extracts the component name from a local reference such as
+// "#/components/schemas/Pet"
+func componentName(ref, kind string) (string, error) {
+	prefix := "#/components/" + kind + "/"
+	if !strings.HasPrefix(ref, prefix) {
+		return "", fmt.Errorf("unsupported reference %q", ref)
+	}
+	name := strings.TrimPrefix(ref, prefix)
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(name), nil
+}
@@ -0,0 +1,222 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/paulgrammer/ultravox/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const petstoreYAML = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://pets.example.com/v1/
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      summary: List all pets
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+            maximum: 100
+    post:
+      operationId: createPet
+      description: Create a pet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/NewPet'
+  /pets/{petId}:
+    parameters:
+      - $ref: '#/components/parameters/PetID'
+    get:
+      operationId: getPet
+      summary: Info for a specific pet
+      parameters:
+        - name: X-Request-ID
+          in: header
+          required: true
+          schema:
+            type: string
+components:
+  parameters:
+    PetID:
+      name: petId
+      in: path
+      schema:
+        type: string
+  schemas:
+    NewPet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+        tag:
+          $ref: '#/components/schemas/Tag'
+    Tag:
+      type: string
+      enum: [cat, dog]
+`
+
+func TestToolsFromOpenAPISpec(t *testing.T) {
+	defs, err := tools.ToolsFromOpenAPISpec([]byte(petstoreYAML))
+	require.NoError(t, err)
+	require.Len(t, defs, 3)
+
+	list := defs[0]
+	assert.Equal(t, "listPets", list.ModelToolName)
+	assert.Equal(t, "List all pets", list.Description)
+	assert.Equal(t, &ultravox.BaseHTTPToolDetails{
+		BaseURLPattern: "https://pets.example.com/v1/pets",
+		HTTPMethod:     "GET",
+	}, list.HTTP)
+	require.Len(t, list.DynamicParameters, 1)
+	assert.Equal(t, "limit", list.DynamicParameters[0].Name)
+	assert.Equal(t, ultravox.ParameterLocationQuery, list.DynamicParameters[0].Location)
+	assert.False(t, list.DynamicParameters[0].Required)
+
+	create := defs[1]
+	assert.Equal(t, "createPet", create.ModelToolName)
+	assert.Equal(t, "Create a pet", create.Description, "description is used when there is no summary")
+	assert.Equal(t, "POST", create.HTTP.HTTPMethod)
+	require.Len(t, create.DynamicParameters, 2)
+	assert.Equal(t, "name", create.DynamicParameters[0].Name)
+	assert.Equal(t, ultravox.ParameterLocationBody, create.DynamicParameters[0].Location)
+	assert.True(t, create.DynamicParameters[0].Required)
+	assert.Equal(t, "tag", create.DynamicParameters[1].Name)
+	assert.False(t, create.DynamicParameters[1].Required)
+
+	schema, err := json.Marshal(create.DynamicParameters[1].Schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "string", "enum": ["cat", "dog"]}`, string(schema), "schema references are resolved")
+
+	get := defs[2]
+	assert.Equal(t, "getPet", get.ModelToolName)
+	assert.Equal(t, "https://pets.example.com/v1/pets/{petId}", get.HTTP.BaseURLPattern)
+	require.Len(t, get.DynamicParameters, 2)
+	assert.Equal(t, "petId", get.DynamicParameters[0].Name)
+	assert.Equal(t, ultravox.ParameterLocationPath, get.DynamicParameters[0].Location)
+	assert.True(t, get.DynamicParameters[0].Required, "path parameters are always required")
+	assert.Equal(t, "X-Request-ID", get.DynamicParameters[1].Name)
+	assert.Equal(t, ultravox.ParameterLocationHeader, get.DynamicParameters[1].Location)
+}
+
+func TestToolsFromOpenAPISpec_JSON(t *testing.T) {
+	spec := `{
+		"openapi": "3.1.0",
+		"servers": [{"url": "https://api.example.com"}],
+		"paths": {
+			"/weather": {
+				"get": {
+					"operationId": "getWeather",
+					"summary": "Current weather for a city",
+					"parameters": [{"name": "city", "in": "query", "required": true, "schema": {"type": "string"}}]
+				}
+			}
+		}
+	}`
+
+	defs, err := tools.ToolsFromOpenAPISpec([]byte(spec))
+	require.NoError(t, err)
+	require.Len(t, defs, 1)
+	assert.Equal(t, "getWeather", defs[0].ModelToolName)
+	assert.Equal(t, "https://api.example.com/weather", defs[0].HTTP.BaseURLPattern)
+	assert.Equal(t, []ultravox.DynamicParameter{
+		{
+			Name:     "city",
+			Location: ultravox.ParameterLocationQuery,
+			Schema:   map[string]interface{}{"type": "string"},
+			Required: true,
+		},
+	}, defs[0].DynamicParameters)
+}
+
+func TestToolsFromOpenAPISpec_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name:    "Invalid document",
+			spec:    "openapi: [",
+			wantErr: "failed to parse OpenAPI spec",
+		},
+		{
+			name:    "Swagger 2",
+			spec:    "swagger: '2.0'\n",
+			wantErr: "unsupported OpenAPI version",
+		},
+		{
+			name:    "No servers",
+			spec:    "openapi: 3.0.0\npaths: {}\n",
+			wantErr: "no server URL",
+		},
+		{
+			name: "Missing operationId",
+			spec: `
+openapi: 3.0.0
+servers: [{url: "https://api.example.com"}]
+paths:
+  /ping:
+    get:
+      summary: Ping
+`,
+			wantErr: "GET /ping: operationId is required",
+		},
+		{
+			name: "Cookie parameter",
+			spec: `
+openapi: 3.0.0
+servers: [{url: "https://api.example.com"}]
+paths:
+  /ping:
+    get:
+      operationId: ping
+      parameters:
+        - {name: session, in: cookie, schema: {type: string}}
+`,
+			wantErr: `unsupported location "cookie"`,
+		},
+		{
+			name: "Recursive schema",
+			spec: `
+openapi: 3.0.0
+servers: [{url: "https://api.example.com"}]
+paths:
+  /nodes:
+    post:
+      operationId: createNode
+      requestBody:
+        content:
+          application/json:
+            schema: {$ref: '#/components/schemas/Node'}
+components:
+  schemas:
+    Node:
+      type: object
+      properties:
+        child: {$ref: '#/components/schemas/Node'}
+`,
+			wantErr: "recursive schema reference",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tools.ToolsFromOpenAPISpec([]byte(tt.spec))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package tools
+
+// ToolSchemaBuilder builds the JSON Schema of an object whose properties are
+// the arguments of a tool, for use as a DynamicParameter schema
+type ToolSchemaBuilder struct {
+	properties map[string]interface{}
+	required   []string
+}
+
+// NewObjectSchema starts an object schema with no properties
+func NewObjectSchema() *ToolSchemaBuilder {
+	return &ToolSchemaBuilder{properties: make(map[string]interface{})}
+}
+
+// AddStringProperty adds a string property
+func (b *ToolSchemaBuilder) AddStringProperty(name string, required bool, description string) *ToolSchemaBuilder {
+	return b.addProperty(name, required, description, map[string]interface{}{"type": "string"})
+}
+
+// AddNumberProperty adds a number property
+func (b *ToolSchemaBuilder) AddNumberProperty(name string, required bool, description string) *ToolSchemaBuilder {
+	return b.addProperty(name, required, description, map[string]interface{}{"type": "number"})
+}
+
+// AddBoolProperty adds a boolean property
+func (b *ToolSchemaBuilder) AddBoolProperty(name string, required bool, description string) *ToolSchemaBuilder {
+	return b.addProperty(name, required, description, map[string]interface{}{"type": "boolean"})
+}
+
+// AddEnumProperty adds a string property restricted to values
+func (b *ToolSchemaBuilder) AddEnumProperty(name string, values []string, required bool, description string) *ToolSchemaBuilder {
+	return b.addProperty(name, required, description, map[string]interface{}{
+		"type": "string",
+		"enum": append([]string(nil), values...),
+	})
+}
+
+// addProperty records a property schema, replacing any earlier property with the same name
+func (b *ToolSchemaBuilder) addProperty(name string, required bool, description string, schema map[string]interface{}) *ToolSchemaBuilder {
+	if description != "" {
+		schema["description"] = description
+	}
+	b.properties[name] = schema
+
+	if required {
+		for _, r := range b.required {
+			if r == name {
+				return b
+			}
+		}
+		b.required = append(b.required, name)
+	}
+	return b
+}
+
+// Build returns the schema as a JSON Schema object. The "required" keyword is
+// only present when at least one property is required.
+func (b *ToolSchemaBuilder) Build() map[string]interface{} {
+	properties := make(map[string]interface{}, len(b.properties))
+	for name, schema := range b.properties {
+		properties[name] = schema
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(b.required) > 0 {
+		schema["required"] = append([]string(nil), b.required...)
+	}
+	return schema
+}
@@ -0,0 +1,71 @@
+package tools_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/paulgrammer/ultravox/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToolSchemaBuilder(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *tools.ToolSchemaBuilder
+		wantJSON string
+	}{
+		{
+			name:     "Empty object",
+			builder:  tools.NewObjectSchema(),
+			wantJSON: `{"type": "object", "properties": {}}`,
+		},
+		{
+			name: "All property kinds",
+			builder: tools.NewObjectSchema().
+				AddStringProperty("city", true, "The city to look up").
+				AddNumberProperty("days", false, "").
+				AddBoolProperty("alerts", false, "Include weather alerts").
+				AddEnumProperty("units", []string{"metric", "imperial"}, true, "Unit system"),
+			wantJSON: `{
+				"type": "object",
+				"properties": {
+					"city": {"type": "string", "description": "The city to look up"},
+					"days": {"type": "number"},
+					"alerts": {"type": "boolean", "description": "Include weather alerts"},
+					"units": {"type": "string", "enum": ["metric", "imperial"], "description": "Unit system"}
+				},
+				"required": ["city", "units"]
+			}`,
+		},
+		{
+			name: "Redefined property",
+			builder: tools.NewObjectSchema().
+				AddStringProperty("city", true, "").
+				AddStringProperty("city", true, "The city to look up"),
+			wantJSON: `{
+				"type": "object",
+				"properties": {"city": {"type": "string", "description": "The city to look up"}},
+				"required": ["city"]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.builder.Build())
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantJSON, string(data))
+		})
+	}
+}
+
+func TestToolSchemaBuilder_BuildIsIndependent(t *testing.T) {
+	builder := tools.NewObjectSchema().AddStringProperty("city", true, "")
+	first := builder.Build()
+
+	builder.AddStringProperty("country", true, "")
+
+	assert.Len(t, first["properties"], 1)
+	assert.Equal(t, []string{"city"}, first["required"])
+}
@@ -0,0 +1,306 @@
+// Package ultravoxtest provides an in-process fake of the Ultravox REST API for
+// exercising code built on the ultravox client without network access. Calls
+// created on the fake can be joined with Client.Join; the session echoes text
+// sent with Session.SendText back as transcript events.
+package ultravoxtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/paulgrammer/ultravox"
+)
+
+// APIKey is the key accepted by clients returned from MockServer.Client
+const APIKey = "test-api-key"
+
+// MockServer is a local HTTP server implementing the calls endpoints of the
+// Ultravox API. Created calls are kept in memory so they can be fetched and
+// deleted again through the client.
+type MockServer struct {
+	server *httptest.Server
+
+	mu       sync.Mutex
+	response *ultravox.Call
+	calls    map[string]*ultravox.Call
+	requests []*ultravox.CallRequest
+	sessions map[*websocket.Conn]struct{}
+	status   int
+	delay    time.Duration
+	nextID   int
+}
+
+// NewMockServer starts a mock server. Callers should Close it when done.
+func NewMockServer() *MockServer {
+	m := &MockServer{
+		calls:    make(map[string]*ultravox.Call),
+		sessions: make(map[*websocket.Conn]struct{}),
+	}
+
+	api := http.NewServeMux()
+	api.HandleFunc("POST /api/calls", m.handleCreateCall)
+	api.HandleFunc("POST /api/agents/{agentId}/calls", m.handleCreateCall)
+	api.HandleFunc("GET /api/calls/{callId}", m.handleGetCall)
+	api.HandleFunc("DELETE /api/calls/{callId}", m.handleDeleteCall)
+
+	// Join URLs are authorized by the call ID alone, as with the real API
+	mux := http.NewServeMux()
+	mux.Handle("/api/", m.intercept(api))
+	mux.HandleFunc("GET /join/{callId}", m.handleJoin)
+
+	m.server = httptest.NewServer(mux)
+	return m
+}
+
+// URL returns the API base URL to pass to ultravox.WithAPIBaseURL
+func (m *MockServer) URL() string {
+	return m.server.URL + "/api"
+}
+
+// Client returns a client configured to talk to the mock server
+func (m *MockServer) Client(opts ...ultravox.Option) *ultravox.Client {
+	defaults := []ultravox.Option{
+		ultravox.WithAPIKey(APIKey),
+		ultravox.WithAPIBaseURL(m.URL()),
+	}
+	return ultravox.NewClient(append(defaults, opts...)...)
+}
+
+// Server returns the underlying test server
+func (m *MockServer) Server() *httptest.Server {
+	return m.server
+}
+
+// Close ends any joined sessions and shuts down the server
+func (m *MockServer) Close() {
+	m.mu.Lock()
+	for conn := range m.sessions {
+		conn.Close()
+	}
+	m.mu.Unlock()
+	m.server.Close()
+}
+
+// SetResponse sets the call returned by subsequent create requests. A missing
+// CallID or JoinURL is filled in for each created call.
+func (m *MockServer) SetResponse(resp *ultravox.Call) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.response = resp
+}
+
+// SetStatus makes every request fail with the given status code.
+// Passing 0 restores normal behaviour.
+func (m *MockServer) SetStatus(code int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.status = code
+}
+
+// SetDelay delays every response by d, or until the request is cancelled
+func (m *MockServer) SetDelay(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.delay = d
+}
+
+// LastRequest returns the most recent call creation request, or nil if none was received
+func (m *MockServer) LastRequest() *ultravox.CallRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.requests) == 0 {
+		return nil
+	}
+	return m.requests[len(m.requests)-1]
+}
+
+// Requests returns every call creation request received, oldest first
+func (m *MockServer) Requests() []*ultravox.CallRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*ultravox.CallRequest(nil), m.requests...)
+}
+
+// intercept applies the configured delay, status override and API key check before routing
+func (m *MockServer) intercept(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		delay, status := m.delay, m.status
+		m.mu.Unlock()
+
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		if r.Header.Get("X-API-Key") != APIKey {
+			writeError(w, http.StatusUnauthorized)
+			return
+		}
+
+		if status != 0 {
+			writeError(w, status)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *MockServer) handleCreateCall(w http.ResponseWriter, r *http.Request) {
+	var req ultravox.CallRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+	req.AgentID = r.PathValue("agentId")
+
+	m.mu.Lock()
+	m.requests = append(m.requests, &req)
+
+	call := &ultravox.Call{}
+	if m.response != nil {
+		*call = *m.response
+	}
+	if call.CallID == "" {
+		m.nextID++
+		call.CallID = fmt.Sprintf("call-%d", m.nextID)
+	}
+	if call.JoinURL == "" {
+		call.JoinURL = "ws" + strings.TrimPrefix(m.server.URL, "http") + "/join/" + call.CallID
+	}
+	if call.Created == "" {
+		call.Created = time.Now().UTC().Format(time.RFC3339)
+	}
+	m.calls[call.CallID] = call
+	m.mu.Unlock()
+
+	writeJSON(w, http.StatusCreated, call)
+}
+
+func (m *MockServer) handleGetCall(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	call, ok := m.calls[r.PathValue("callId")]
+	m.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, call)
+}
+
+func (m *MockServer) handleDeleteCall(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("callId")
+
+	m.mu.Lock()
+	_, ok := m.calls[id]
+	delete(m.calls, id)
+	m.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleJoin serves the WebSocket of a created call. It reports the agent as
+// listening, then answers each input_text_message with a final user transcript
+// of the text followed by an agent transcript echoing it. Audio is ignored.
+func (m *MockServer) handleJoin(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	_, ok := m.calls[r.PathValue("callId")]
+	m.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	m.mu.Lock()
+	m.sessions[conn] = struct{}{}
+	m.mu.Unlock()
+	defer func() {
+		m.mu.Lock()
+		delete(m.sessions, conn)
+		m.mu.Unlock()
+		conn.Close()
+	}()
+
+	if err := conn.WriteJSON(ultravoxEvent(&ultravox.StateEvent{State: "listening"})); err != nil {
+		return
+	}
+
+	ordinal := 0
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+
+		var msg struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		}
+		if json.Unmarshal(data, &msg) != nil || msg.Type != "input_text_message" {
+			continue
+		}
+
+		for _, role := range []string{"user", "agent"} {
+			transcript := &ultravox.TranscriptEvent{
+				Role:    role,
+				Medium:  ultravox.OutputMediumText,
+				Text:    msg.Text,
+				Final:   true,
+				Ordinal: ordinal,
+			}
+			ordinal++
+			if err := conn.WriteJSON(ultravoxEvent(transcript)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// upgrader accepts join requests from any origin
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// ultravoxEvent adds the type discriminator to an event so that it can be
+// decoded by ultravox.DecodeEvent
+func ultravoxEvent(ev ultravox.Event) map[string]interface{} {
+	data, _ := json.Marshal(ev)
+	var fields map[string]interface{}
+	json.Unmarshal(data, &fields)
+	fields["type"] = ev.EventType()
+	return fields
+}
+
+// writeJSON writes v as a JSON response body
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error body in the shape returned by the Ultravox API
+func writeError(w http.ResponseWriter, status int) {
+	writeJSON(w, status, map[string]string{"detail": http.StatusText(status)})
+}
@@ -0,0 +1,162 @@
+package ultravoxtest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/paulgrammer/ultravox/ultravoxtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockServer_CallLifecycle(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	call, err := client.Call(ctx, ultravox.WithCallSystemPrompt("Be brief."))
+	require.NoError(t, err)
+	assert.NotEmpty(t, call.CallID)
+	assert.NotEmpty(t, call.JoinURL)
+
+	req := server.LastRequest()
+	require.NotNil(t, req)
+	assert.Equal(t, "Be brief.", req.SystemPrompt)
+
+	fetched, err := client.GetCall(ctx, call.CallID)
+	require.NoError(t, err)
+	assert.Equal(t, call.CallID, fetched.CallID)
+
+	require.NoError(t, client.DeleteCall(ctx, call.CallID))
+
+	_, err = client.GetCall(ctx, call.CallID)
+	assert.True(t, errors.Is(err, ultravox.ErrNotFound))
+}
+
+func TestMockServer_AgentCall(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	_, err := server.Client().CallAgent(context.Background(), "agent-123")
+	require.NoError(t, err)
+	assert.Equal(t, "agent-123", server.LastRequest().AgentID)
+}
+
+func TestMockServer_SetResponse(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	server.SetResponse(&ultravox.Call{
+		CallID:  "fixed-id",
+		JoinURL: "wss://example.com/join",
+		Summary: "canned",
+	})
+
+	call, err := server.Client().Call(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "fixed-id", call.CallID)
+	assert.Equal(t, "canned", call.Summary)
+}
+
+func TestMockServer_SetStatus(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	server.SetStatus(http.StatusServiceUnavailable)
+
+	_, err := server.Client().Call(context.Background())
+
+	var apiErr *ultravox.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+}
+
+func TestMockServer_SetDelay(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	server.SetDelay(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := server.Client().Call(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestMockServer_RequiresAPIKey(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	client := ultravox.NewClient(ultravox.WithAPIKey("wrong"), ultravox.WithAPIBaseURL(server.URL()))
+
+	_, err := client.Call(context.Background())
+
+	var apiErr *ultravox.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+}
+
+func TestMockServer_Join(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	client := server.Client()
+	ctx := context.Background()
+
+	call, err := client.Call(ctx)
+	require.NoError(t, err)
+
+	session, err := client.Join(ctx, call)
+	require.NoError(t, err)
+	defer session.Close()
+
+	ev, err := session.ReadEvent()
+	require.NoError(t, err)
+	assert.Equal(t, &ultravox.StateEvent{State: "listening"}, ev)
+
+	require.NoError(t, session.SendText("Where is my order?"))
+
+	for i, role := range []string{"user", "agent"} {
+		ev, err := session.ReadEvent()
+		require.NoError(t, err)
+		transcript, ok := ev.(*ultravox.TranscriptEvent)
+		require.True(t, ok, "expected a transcript, got %T", ev)
+		assert.Equal(t, role, transcript.Role)
+		assert.Equal(t, "Where is my order?", transcript.Text)
+		assert.True(t, transcript.Final)
+		assert.Equal(t, i, transcript.Ordinal)
+	}
+
+	t.Run("Unknown call", func(t *testing.T) {
+		_, err := client.Join(ctx, &ultravox.Call{JoinURL: "ws" + strings.TrimPrefix(server.Server().URL, "http") + "/join/missing"})
+		assert.Error(t, err)
+	})
+}
+
+func TestMockServer_Requests(t *testing.T) {
+	server := ultravoxtest.NewMockServer()
+	defer server.Close()
+
+	assert.Nil(t, server.LastRequest())
+
+	client := server.Client()
+	_, err := client.Call(context.Background(), ultravox.WithCallSystemPrompt("first"))
+	require.NoError(t, err)
+	_, err = client.Call(context.Background(), ultravox.WithCallSystemPrompt("second"), ultravox.WithCallRecordingEnabled(true))
+	require.NoError(t, err)
+
+	requests := server.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, "first", requests[0].SystemPrompt)
+	assert.Equal(t, "second", requests[1].SystemPrompt)
+	assert.True(t, requests[1].RecordingEnabled)
+	assert.Same(t, requests[1], server.LastRequest())
+}
@@ -1,12 +1,54 @@
 package ultravox
 
+import (
+	"maps"
+	"slices"
+)
+
 // ExternalVoice contains configurations for external voice providers
 type ExternalVoice struct {
-	ElevenLabs *ElevenLabsVoice `json:"elevenLabs,omitempty" yaml:"elevenLabs,omitempty"`
-	Cartesia   *CartesiaVoice   `json:"cartesia,omitempty" yaml:"cartesia,omitempty"`
-	PlayHt     *PlayHtVoice     `json:"playHt,omitempty" yaml:"playHt,omitempty"`
-	Lmnt       *LmntVoice       `json:"lmnt,omitempty" yaml:"lmnt,omitempty"`
-	Generic    *GenericVoice    `json:"generic,omitempty" yaml:"generic,omitempty"`
+	ElevenLabs  *ElevenLabsVoice     `json:"elevenLabs,omitempty" yaml:"elevenLabs,omitempty"`
+	Cartesia    *CartesiaVoice       `json:"cartesia,omitempty" yaml:"cartesia,omitempty"`
+	PlayHt      *PlayHtVoice         `json:"playHt,omitempty" yaml:"playHt,omitempty"`
+	Lmnt        *LmntVoice           `json:"lmnt,omitempty" yaml:"lmnt,omitempty"`
+	Generic     *GenericVoice        `json:"generic,omitempty" yaml:"generic,omitempty"`
+	Azure       *AzureTTSVoice       `json:"azure,omitempty" yaml:"azure,omitempty"`
+	GoogleCloud *GoogleCloudTTSVoice `json:"googleCloud,omitempty" yaml:"googleCloud,omitempty"`
+	AmazonPolly *AmazonPollyVoice    `json:"amazonPolly,omitempty" yaml:"amazonPolly,omitempty"`
+	OpenAI      *OpenAITTSVoice      `json:"openai,omitempty" yaml:"openai,omitempty"`
+	Deepgram    *DeepgramTTSVoice    `json:"deepgram,omitempty" yaml:"deepgram,omitempty"`
+}
+
+// clone returns a deep copy of the voice. A generic voice's Body is shared.
+func (v *ExternalVoice) clone() *ExternalVoice {
+	if v == nil {
+		return nil
+	}
+	c := ExternalVoice{
+		ElevenLabs:  clonePtr(v.ElevenLabs),
+		Cartesia:    clonePtr(v.Cartesia),
+		PlayHt:      clonePtr(v.PlayHt),
+		Lmnt:        clonePtr(v.Lmnt),
+		Generic:     clonePtr(v.Generic),
+		Azure:       clonePtr(v.Azure),
+		GoogleCloud: clonePtr(v.GoogleCloud),
+		AmazonPolly: clonePtr(v.AmazonPolly),
+		OpenAI:      clonePtr(v.OpenAI),
+		Deepgram:    clonePtr(v.Deepgram),
+	}
+	if c.ElevenLabs != nil {
+		c.ElevenLabs.PronunciationDictionaries = slices.Clone(v.ElevenLabs.PronunciationDictionaries)
+	}
+	if c.Cartesia != nil {
+		c.Cartesia.Emotions = slices.Clone(v.Cartesia.Emotions)
+	}
+	if c.Generic != nil {
+		c.Generic.Headers = maps.Clone(v.Generic.Headers)
+	}
+	if c.AmazonPolly != nil {
+		c.AmazonPolly.LexiconNames = slices.Clone(v.AmazonPolly.LexiconNames)
+	}
+	return &c
 }
 
 // ElevenLabsVoice defines configuration for ElevenLabs voice service
@@ -23,6 +65,16 @@ type ElevenLabsVoice struct {
 	MaxSampleRate             int                       `json:"maxSampleRate,omitempty" yaml:"maxSampleRate,omitempty"`
 }
 
+// AddPronunciationDictionary appends a pronunciation dictionary to the voice
+// and returns the voice for chaining. An empty versionID uses the latest version.
+func (v *ElevenLabsVoice) AddPronunciationDictionary(dictionaryID, versionID string) *ElevenLabsVoice {
+	v.PronunciationDictionaries = append(v.PronunciationDictionaries, PronunciationDictionary{
+		DictionaryID: dictionaryID,
+		VersionID:    versionID,
+	})
+	return v
+}
+
 // PronunciationDictionary references a pronunciation dictionary in ElevenLabs
 type PronunciationDictionary struct {
 	DictionaryID string `json:"dictionaryId" yaml:"dictionaryId"`
@@ -71,6 +123,40 @@ type GenericVoice struct {
 	ResponseMimeType       string            `json:"responseMimeType,omitempty" yaml:"responseMimeType,omitempty"`
 }
 
+// AzureTTSVoice defines configuration for Azure Cognitive Services text-to-speech
+type AzureTTSVoice struct {
+	VoiceID      string `json:"voiceId" yaml:"voiceId"`
+	Region       string `json:"region" yaml:"region"`
+	DeploymentID string `json:"deploymentId,omitempty" yaml:"deploymentId,omitempty"`
+}
+
+// GoogleCloudTTSVoice defines configuration for Google Cloud Text-to-Speech
+type GoogleCloudTTSVoice struct {
+	VoiceName    string `json:"voiceName" yaml:"voiceName"`
+	LanguageCode string `json:"languageCode" yaml:"languageCode"`
+	AudioProfile string `json:"audioProfile,omitempty" yaml:"audioProfile,omitempty"`
+}
+
+// AmazonPollyVoice defines configuration for Amazon Polly voice service
+type AmazonPollyVoice struct {
+	VoiceID      string   `json:"voiceId" yaml:"voiceId"`
+	Engine       string   `json:"engine,omitempty" yaml:"engine,omitempty"`
+	LexiconNames []string `json:"lexiconNames,omitempty" yaml:"lexiconNames,omitempty"`
+}
+
+// OpenAITTSVoice defines configuration for OpenAI text-to-speech, e.g. the tts-1 and tts-1-hd models
+type OpenAITTSVoice struct {
+	VoiceID string  `json:"voiceId" yaml:"voiceId"`
+	Model   string  `json:"model,omitempty" yaml:"model,omitempty"`
+	Speed   float64 `json:"speed,omitempty" yaml:"speed,omitempty"`
+}
+
+// DeepgramTTSVoice defines configuration for Deepgram Aura text-to-speech
+type DeepgramTTSVoice struct {
+	Model     string `json:"model" yaml:"model"`
+	VoiceName string `json:"voiceName,omitempty" yaml:"voiceName,omitempty"`
+}
+
 // NewElevenLabsVoice creates a new ElevenLabs voice configuration
 func NewElevenLabsVoice(voiceID string) *ExternalVoice {
 	return &ExternalVoice{
@@ -117,3 +203,61 @@ func NewGenericVoice(url string, body interface{}) *ExternalVoice {
 		},
 	}
 }
+
+// NewGenericVoiceWithOptions creates a generic voice configuration for a TTS
+// service that needs request headers or describes its response format
+func NewGenericVoiceWithOptions(url string, body interface{}, opts GenericVoiceOptions) *ExternalVoice {
+	voice := NewGenericVoice(url, body)
+	voice.Generic.Headers = maps.Clone(opts.Headers)
+	voice.Generic.ResponseSampleRate = opts.ResponseSampleRate
+	voice.Generic.ResponseWordsPerMinute = opts.ResponseWordsPerMinute
+	voice.Generic.ResponseMimeType = opts.ResponseMimeType
+	return voice
+}
+
+// NewAzureTTSVoice creates a new Azure text-to-speech voice configuration
+func NewAzureTTSVoice(voiceID, region string) *ExternalVoice {
+	return &ExternalVoice{
+		Azure: &AzureTTSVoice{
+			VoiceID: voiceID,
+			Region:  region,
+		},
+	}
+}
+
+// NewGoogleCloudTTSVoice creates a new Google Cloud text-to-speech voice configuration
+func NewGoogleCloudTTSVoice(voiceName, languageCode string) *ExternalVoice {
+	return &ExternalVoice{
+		GoogleCloud: &GoogleCloudTTSVoice{
+			VoiceName:    voiceName,
+			LanguageCode: languageCode,
+		},
+	}
+}
+
+// NewAmazonPollyVoice creates a new Amazon Polly voice configuration
+func NewAmazonPollyVoice(voiceID string) *ExternalVoice {
+	return &ExternalVoice{
+		AmazonPolly: &AmazonPollyVoice{
+			VoiceID: voiceID,
+		},
+	}
+}
+
+// NewOpenAITTSVoice creates a new OpenAI text-to-speech voice configuration
+func NewOpenAITTSVoice(voiceID string) *ExternalVoice {
+	return &ExternalVoice{
+		OpenAI: &OpenAITTSVoice{
+			VoiceID: voiceID,
+		},
+	}
+}
+
+// NewDeepgramTTSVoice creates a new Deepgram text-to-speech voice configuration
+func NewDeepgramTTSVoice(model string) *ExternalVoice {
+	return &ExternalVoice{
+		Deepgram: &DeepgramTTSVoice{
+			Model: model,
+		},
+	}
+}
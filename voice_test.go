@@ -0,0 +1,241 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalVoice_Providers(t *testing.T) {
+	tests := []struct {
+		name     string
+		voice    *ultravox.ExternalVoice
+		wantJSON string
+	}{
+		{
+			name:     "Azure",
+			voice:    ultravox.NewAzureTTSVoice("en-US-JennyNeural", "eastus"),
+			wantJSON: `{"azure": {"voiceId": "en-US-JennyNeural", "region": "eastus"}}`,
+		},
+		{
+			name:     "Google Cloud",
+			voice:    ultravox.NewGoogleCloudTTSVoice("en-US-Neural2-C", "en-US"),
+			wantJSON: `{"googleCloud": {"voiceName": "en-US-Neural2-C", "languageCode": "en-US"}}`,
+		},
+		{
+			name:     "Amazon Polly",
+			voice:    ultravox.NewAmazonPollyVoice("Joanna"),
+			wantJSON: `{"amazonPolly": {"voiceId": "Joanna"}}`,
+		},
+		{
+			name:     "OpenAI",
+			voice:    ultravox.NewOpenAITTSVoice("alloy"),
+			wantJSON: `{"openai": {"voiceId": "alloy"}}`,
+		},
+		{
+			name:     "Deepgram",
+			voice:    ultravox.NewDeepgramTTSVoice("aura-asteria-en"),
+			wantJSON: `{"deepgram": {"model": "aura-asteria-en"}}`,
+		},
+		{
+			name: "Generic with options",
+			voice: ultravox.NewGenericVoiceWithOptions("https://tts.example.com/speak", map[string]string{"text": "{text}"}, ultravox.GenericVoiceOptions{
+				Headers:            map[string]string{"Authorization": "Bearer token"},
+				ResponseSampleRate: 22050,
+				ResponseMimeType:   "audio/mpeg",
+			}),
+			wantJSON: `{"generic": {
+				"url": "https://tts.example.com/speak",
+				"body": {"text": "{text}"},
+				"headers": {"Authorization": "Bearer token"},
+				"responseSampleRate": 22050,
+				"responseMimeType": "audio/mpeg"
+			}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.voice)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantJSON, string(data))
+		})
+	}
+}
+
+func TestWithCallProviderVoices(t *testing.T) {
+	tests := []struct {
+		name     string
+		opt      ultravox.CallOption
+		wantJSON string
+	}{
+		{
+			name: "Azure",
+			opt: ultravox.WithCallAzureTTSVoice("en-US-JennyNeural", "eastus", &ultravox.AzureTTSVoiceOptions{
+				DeploymentID: "custom-voice-deployment",
+			}),
+			wantJSON: `{"azure": {
+				"voiceId": "en-US-JennyNeural",
+				"region": "eastus",
+				"deploymentId": "custom-voice-deployment"
+			}}`,
+		},
+		{
+			name: "Google Cloud",
+			opt: ultravox.WithCallGoogleCloudTTSVoice("en-US-Neural2-C", "en-US", &ultravox.GoogleCloudTTSVoiceOptions{
+				AudioProfile: "telephony-class-application",
+			}),
+			wantJSON: `{"googleCloud": {
+				"voiceName": "en-US-Neural2-C",
+				"languageCode": "en-US",
+				"audioProfile": "telephony-class-application"
+			}}`,
+		},
+		{
+			name: "Amazon Polly",
+			opt: ultravox.WithCallAmazonPollyVoice("Joanna", &ultravox.AmazonPollyVoiceOptions{
+				Engine:       "neural",
+				LexiconNames: []string{"brand-names"},
+			}),
+			wantJSON: `{"amazonPolly": {
+				"voiceId": "Joanna",
+				"engine": "neural",
+				"lexiconNames": ["brand-names"]
+			}}`,
+		},
+		{
+			name:     "Amazon Polly without options",
+			opt:      ultravox.WithCallAmazonPollyVoice("Matthew", nil),
+			wantJSON: `{"amazonPolly": {"voiceId": "Matthew"}}`,
+		},
+		{
+			name: "OpenAI",
+			opt: ultravox.WithCallOpenAITTSVoice("nova", &ultravox.OpenAITTSVoiceOptions{
+				Model: "tts-1-hd",
+				Speed: 1.25,
+			}),
+			wantJSON: `{"openai": {"voiceId": "nova", "model": "tts-1-hd", "speed": 1.25}}`,
+		},
+		{
+			name: "Deepgram",
+			opt: ultravox.WithCallDeepgramTTSVoice("aura-2", &ultravox.DeepgramTTSVoiceOptions{
+				VoiceName: "thalia",
+			}),
+			wantJSON: `{"deepgram": {"model": "aura-2", "voiceName": "thalia"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var request ultravox.CallRequest
+			tt.opt(&request)
+
+			data, err := json.Marshal(request.ExternalVoice)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.wantJSON, string(data))
+		})
+	}
+}
+
+func TestWithCallElevenLabsPronunciation(t *testing.T) {
+	t.Run("After ElevenLabs voice", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallElevenLabsVoice("voice-123", &ultravox.ElevenLabsVoiceOptions{Model: "eleven_turbo_v2"})(&request)
+		ultravox.WithCallElevenLabsPronunciation("dict-1", "v1")(&request)
+		ultravox.WithCallElevenLabsPronunciation("dict-2", "")(&request)
+
+		data, err := json.Marshal(request.ExternalVoice)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"elevenLabs": {
+			"voiceId": "voice-123",
+			"model": "eleven_turbo_v2",
+			"pronunciationDictionaries": [
+				{"dictionaryId": "dict-1", "versionId": "v1"},
+				{"dictionaryId": "dict-2"}
+			]
+		}}`, string(data))
+	})
+
+	t.Run("After voice ID", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallVoice("voice-123")(&request)
+		ultravox.WithCallElevenLabsPronunciation("dict-1", "v1")(&request)
+
+		assert.Empty(t, request.Voice)
+		require.NotNil(t, request.ExternalVoice.ElevenLabs)
+		assert.Equal(t, "voice-123", request.ExternalVoice.ElevenLabs.VoiceID)
+		assert.Len(t, request.ExternalVoice.ElevenLabs.PronunciationDictionaries, 1)
+	})
+
+	t.Run("Client default voice is not modified", func(t *testing.T) {
+		var body map[string]interface{}
+		client := ultravox.NewClient(
+			ultravox.WithAPIKey("test-api-key"),
+			ultravox.WithExternalVoice(ultravox.NewElevenLabsVoice("voice-123")),
+		)
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				body = nil
+				require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joinUrl": "wss://example.com/join"}`)),
+				}, nil
+			},
+		})
+
+		_, err := client.Call(context.Background(), ultravox.WithCallElevenLabsPronunciation("dict-1", ""))
+		require.NoError(t, err)
+		assert.Contains(t, body["externalVoice"], "elevenLabs")
+
+		_, err = client.Call(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"elevenLabs": map[string]interface{}{"voiceId": "voice-123"}}, body["externalVoice"])
+	})
+
+	t.Run("Other provider", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+
+		_, err := client.Call(context.Background(),
+			ultravox.WithCallCartesiaVoice("voice-456", nil),
+			ultravox.WithCallElevenLabsPronunciation("dict-1", ""),
+		)
+		assert.ErrorContains(t, err, "require an ElevenLabs voice")
+	})
+}
+
+func TestElevenLabsVoice_AddPronunciationDictionary(t *testing.T) {
+	voice := ultravox.NewElevenLabsVoice("voice-123")
+	voice.ElevenLabs.AddPronunciationDictionary("dict-1", "v2").AddPronunciationDictionary("dict-2", "")
+
+	assert.Equal(t, []ultravox.PronunciationDictionary{
+		{DictionaryID: "dict-1", VersionID: "v2"},
+		{DictionaryID: "dict-2"},
+	}, voice.ElevenLabs.PronunciationDictionaries)
+
+	t.Run("Call option", func(t *testing.T) {
+		var request ultravox.CallRequest
+		ultravox.WithCallVoice("Mark")(&request)
+		ultravox.WithCallElevenLabsDictionary("voice-123", "dict-1", "v2")(&request)
+		ultravox.WithCallElevenLabsPronunciation("dict-2", "")(&request)
+		require.NoError(t, request.Validate())
+
+		assert.Empty(t, request.Voice)
+		data, err := json.Marshal(request.ExternalVoice)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"elevenLabs": {
+			"voiceId": "voice-123",
+			"pronunciationDictionaries": [
+				{"dictionaryId": "dict-1", "versionId": "v2"},
+				{"dictionaryId": "dict-2"}
+			]
+		}}`, string(data))
+	})
+}
@@ -0,0 +1,117 @@
+package ultravox
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// watchPollInterval is how long WatchCallEvents waits before polling again
+// once it has caught up with a call's events
+const watchPollInterval = 500 * time.Millisecond
+
+// watchEndCheckPolls is how many polls in a row must find no new events
+// before WatchCallEvents asks GetCall whether the call has ended
+const watchEndCheckPolls = 3
+
+// WatchCallEvents polls a call's events and delivers each one as it is
+// recorded, for applications that prefer REST polling to a WebSocket session.
+// Both channels are closed once the call ends, ctx is cancelled or a request
+// fails; a failure is sent on the error channel first. The end of the call is
+// detected from its events or, once no new events have been listed for a
+// few polls, from GetCall, so a call that ends without an end event still
+// stops the watch.
+func (c *Client) WatchCallEvents(ctx context.Context, callID string, opts ...ListOption) (<-chan CallEvent, <-chan error) {
+	events := make(chan CallEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		// The API pages with opaque cursors, so the watcher re-reads its
+		// current page and skips the events already delivered from it
+		var cursor string
+		var seen int
+		var ended bool
+		var idle int
+		for {
+			pageOpts := opts
+			if cursor != "" {
+				pageOpts = append(slices.Clip(opts), WithCursor(cursor))
+			}
+
+			page, err := c.ListCallEvents(ctx, callID, pageOpts...)
+			if err != nil {
+				if ctx.Err() == nil {
+					errs <- err
+				}
+				return
+			}
+
+			fresh := page.Results[min(seen, len(page.Results)):]
+			for _, ev := range fresh {
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return
+				}
+				if isCallEndEvent(ev) {
+					return
+				}
+			}
+			seen = len(page.Results)
+
+			if next := page.NextCursor(); next != "" {
+				cursor, seen = next, 0
+				continue
+			}
+
+			// Events recorded just before the call ended may not have been
+			// listed yet, so a call seen to have ended gets one final poll
+			if ended {
+				return
+			}
+
+			// Checking the call on every poll would double the requests, so
+			// it waits until the events have stopped for a while
+			if len(fresh) > 0 {
+				idle = 0
+			} else if idle++; idle >= watchEndCheckPolls {
+				idle = 0
+				call, err := c.GetCall(ctx, callID)
+				if err != nil {
+					if ctx.Err() == nil {
+						errs <- err
+					}
+					return
+				}
+				if call.Ended != "" {
+					ended = true
+					continue
+				}
+			}
+
+			select {
+			case <-time.After(watchPollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// isCallEndEvent reports whether ev records the end of the call, either as a
+// call_ended event or through an endReason in its extras
+func isCallEndEvent(ev CallEvent) bool {
+	if ev.Type == "call_ended" {
+		return true
+	}
+	var extras struct {
+		EndReason string `json:"endReason"`
+	}
+	return json.Unmarshal(ev.Extras, &extras) == nil && extras.EndReason != ""
+}
@@ -0,0 +1,164 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectCallEvents drains a watch, failing the test if it does not finish in time
+func collectCallEvents(t *testing.T, events <-chan ultravox.CallEvent, errs <-chan error) ([]string, error) {
+	t.Helper()
+
+	var types []string
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return types, <-errs
+			}
+			types = append(types, ev.Type)
+		case <-timeout:
+			t.Fatal("watch did not finish")
+		}
+	}
+}
+
+func TestClient_WatchCallEvents(t *testing.T) {
+	pages := map[string][]string{
+		"": {`{"results": [{"type": "call_started"}], "next": "https://api.ultravox.ai/api/calls/call-123/events?cursor=c2"}`},
+		"cursor=c2": {
+			`{"results": [{"type": "tool_failed"}]}`,
+			`{"results": [{"type": "tool_failed"}, {"type": "call_ended", "text": "Caller hung up"}]}`,
+		},
+	}
+
+	var polls atomic.Int32
+	var secondPage int
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/api/calls/call-123" {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"callId": "call-123", "joined": "2024-01-02T03:00:00Z"}`)),
+				}, nil
+			}
+			polls.Add(1)
+			assert.Equal(t, "/api/calls/call-123/events", req.URL.Path)
+
+			responses := pages[req.URL.RawQuery]
+			if !assert.NotEmpty(t, responses, "unexpected query %q", req.URL.RawQuery) {
+				return nil, errors.New("unexpected request")
+			}
+			body := responses[0]
+			if req.URL.RawQuery != "" {
+				body = responses[min(secondPage, len(responses)-1)]
+				secondPage++
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+			}, nil
+		},
+	})
+
+	events, errs := client.WatchCallEvents(context.Background(), "call-123")
+	types, err := collectCallEvents(t, events, errs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"call_started", "tool_failed", "call_ended"}, types, "each event is delivered once")
+	assert.EqualValues(t, 3, polls.Load())
+
+	t.Run("End reason in extras", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(bytes.NewBufferString(`{"results": [
+						{"type": "call_state", "extras": {"endReason": "hangup"}},
+						{"type": "never_delivered"}
+					]}`)),
+				}, nil
+			},
+		})
+
+		events, errs := client.WatchCallEvents(context.Background(), "call-123")
+		types, err := collectCallEvents(t, events, errs)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"call_state"}, types)
+	})
+
+	t.Run("Call ends without an end event", func(t *testing.T) {
+		var eventPolls, callPolls atomic.Int32
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				body := `{"results": [{"type": "call_started"}]}`
+				if req.URL.Path == "/api/calls/call-123" {
+					callPolls.Add(1)
+					body = `{"callId": "call-123", "ended": "2024-01-02T03:04:05Z"}`
+				} else if eventPolls.Add(1) > 1 {
+					body = `{"results": [{"type": "call_started"}, {"type": "tool_failed"}]}`
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(body)),
+				}, nil
+			},
+		})
+
+		events, errs := client.WatchCallEvents(context.Background(), "call-123")
+		types, err := collectCallEvents(t, events, errs)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"call_started", "tool_failed"}, types, "events recorded before the end are still delivered")
+		assert.EqualValues(t, 6, eventPolls.Load(), "the call is checked after three polls without new events, then polled once more")
+		assert.EqualValues(t, 1, callPolls.Load())
+	})
+
+	t.Run("Request error", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"detail": "Not found."}`)),
+				}, nil
+			},
+		})
+
+		events, errs := client.WatchCallEvents(context.Background(), "call-123")
+		_, err := collectCallEvents(t, events, errs)
+		assert.ErrorIs(t, err, ultravox.ErrNotFound)
+	})
+
+	t.Run("Context cancelled", func(t *testing.T) {
+		client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+		client.WithHTTPClient(&MockHTTPClient{
+			DoFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"results": []}`)),
+				}, nil
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events, errs := client.WatchCallEvents(ctx, "call-123")
+		cancel()
+
+		types, err := collectCallEvents(t, events, errs)
+		assert.NoError(t, err)
+		assert.Empty(t, types)
+	})
+}
@@ -0,0 +1,213 @@
+package ultravox
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Request headers carrying a webhook delivery's signature and the time it was signed
+const (
+	WebhookSignatureHeader = "X-Ultravox-Webhook-Signature"
+	WebhookTimestampHeader = "X-Ultravox-Webhook-Timestamp"
+)
+
+// WebhookTimestampTolerance is how far a webhook's timestamp may be from the
+// current time before the delivery is rejected as a possible replay
+const WebhookTimestampTolerance = time.Minute
+
+// Webhook events that can be subscribed to
+const (
+	WebhookEventCallStarted = "call.started"
+	WebhookEventCallJoined  = "call.joined"
+	WebhookEventCallEnded   = "call.ended"
+)
+
+// Webhook is an HTTPS endpoint registered to receive call events
+type Webhook struct {
+	WebhookID string   `json:"webhookId" yaml:"webhookId"`
+	AgentID   string   `json:"agentId,omitempty" yaml:"agentId,omitempty"`
+	URL       string   `json:"url" yaml:"url"`
+	Events    []string `json:"events" yaml:"events"`
+	Secrets   []string `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+	Status    string   `json:"status,omitempty" yaml:"status,omitempty"`
+	Created   string   `json:"created" yaml:"created"`
+}
+
+// WebhookDefinition describes a webhook to create. When AgentID is set, only
+// calls to that agent are delivered. Secrets are generated by the API when
+// none are given.
+type WebhookDefinition struct {
+	URL     string   `json:"url" yaml:"url"`
+	Events  []string `json:"events" yaml:"events"`
+	AgentID string   `json:"agentId,omitempty" yaml:"agentId,omitempty"`
+	Secrets []string `json:"secrets,omitempty" yaml:"secrets,omitempty"`
+}
+
+// WebhookPage is a single page of webhooks returned by ListWebhooks
+type WebhookPage struct {
+	Results  []Webhook `json:"results"`
+	Next     string    `json:"next,omitempty"`
+	Previous string    `json:"previous,omitempty"`
+	Total    int       `json:"total,omitempty"`
+}
+
+// NextCursor returns the cursor for the following page, or an empty string on the last page
+func (p *WebhookPage) NextCursor() string {
+	return cursorFromURL(p.Next)
+}
+
+// ListWebhooks returns a page of the webhooks in the account
+func (c *Client) ListWebhooks(ctx context.Context, opts ...ListOption) (*WebhookPage, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.list_webhooks")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodGet, buildListURL("/webhooks", opts), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page WebhookPage
+	if err := c.do(req, &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// CreateWebhook registers a new webhook from def
+func (c *Client) CreateWebhook(ctx context.Context, def *WebhookDefinition) (*Webhook, error) {
+	if c.config.APIKey == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if def == nil || def.URL == "" {
+		return nil, fmt.Errorf("webhook URL is required")
+	}
+	if len(def.Events) == 0 {
+		return nil, fmt.Errorf("at least one webhook event is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.create_webhook")
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/webhooks", def)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if err := c.do(req, &webhook); err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("webhook.id", webhook.WebhookID))
+
+	return &webhook, nil
+}
+
+// RegisterWebhook subscribes the HTTPS endpoint rawURL to events for every
+// call in the account. Ultravox has no per-call webhooks, so there is no call
+// option for this; register once and use the call ID in each delivery to tell
+// calls apart. Use CreateWebhook to scope a webhook to one agent or to supply
+// your own secrets.
+func (c *Client) RegisterWebhook(ctx context.Context, rawURL string, events ...string) (*Webhook, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("webhook URL must be an https URL, got %q", rawURL)
+	}
+
+	return c.CreateWebhook(ctx, &WebhookDefinition{URL: rawURL, Events: events})
+}
+
+// DeleteWebhook deletes a webhook by its ID
+func (c *Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	if c.config.APIKey == "" {
+		return fmt.Errorf("API key is required")
+	}
+	if webhookID == "" {
+		return fmt.Errorf("webhook ID is required")
+	}
+
+	ctx, span := c.startSpan(ctx, "ultravox.delete_webhook", attribute.String("webhook.id", webhookID))
+	defer span.End()
+
+	req, err := c.newRequest(ctx, http.MethodDelete, "/webhooks/"+url.PathEscape(webhookID), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// VerifyWebhookRequest verifies the signature of the webhook delivery r using
+// secret and returns its body. The body is also restored on r so that it can
+// be decoded afterwards.
+func VerifyWebhookRequest(r *http.Request, secret []byte) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = VerifyWebhookSignature(secret, body, r.Header.Get(WebhookTimestampHeader), r.Header.Get(WebhookSignatureHeader))
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// VerifyWebhookSignature checks that sigHeader, the value of the
+// WebhookSignatureHeader of a webhook request, holds the hex HMAC-SHA256 of
+// payload followed by timestamp, keyed with secret. timestamp is the value of
+// the WebhookTimestampHeader; deliveries signed more than
+// WebhookTimestampTolerance away from now are rejected with
+// ErrWebhookTimestampExpired. The header may list several comma-separated
+// signatures while secrets are being rotated; one match is enough. payload
+// must be the raw request body, before any decoding.
+func VerifyWebhookSignature(secret, payload []byte, timestamp, sigHeader string) error {
+	if len(secret) == 0 {
+		return errors.New("webhook secret is required")
+	}
+
+	signed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrInvalidWebhookSignature, timestamp)
+	}
+	if age := time.Since(signed); age > WebhookTimestampTolerance || age < -WebhookTimestampTolerance {
+		return ErrWebhookTimestampExpired
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	mac.Write([]byte(timestamp))
+	expected := mac.Sum(nil)
+
+	for _, sig := range strings.Split(sigHeader, ",") {
+		got, err := hex.DecodeString(strings.TrimSpace(sig))
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(got, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidWebhookSignature
+}
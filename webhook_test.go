@@ -0,0 +1,232 @@
+package ultravox_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/paulgrammer/ultravox"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webhookResponse = `{
+	"webhookId": "webhook-123",
+	"url": "https://example.com/hooks/ultravox",
+	"events": ["call.ended"],
+	"secrets": ["s3cret"],
+	"status": "normal",
+	"created": "2024-01-02T03:04:05Z"
+}`
+
+func TestClient_ListWebhooks(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/api/webhooks", req.URL.Path)
+			assert.Equal(t, "abc", req.URL.Query().Get("cursor"))
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"results": [` + webhookResponse + `],
+					"next": "https://api.ultravox.ai/api/webhooks?cursor=def"
+				}`)),
+			}, nil
+		},
+	})
+
+	page, err := client.ListWebhooks(context.Background(), ultravox.WithCursor("abc"))
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+	assert.Equal(t, "webhook-123", page.Results[0].WebhookID)
+	assert.Equal(t, []string{ultravox.WebhookEventCallEnded}, page.Results[0].Events)
+	assert.Equal(t, "def", page.NextCursor())
+}
+
+func TestClient_CreateWebhook(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/webhooks", req.URL.String())
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"url":    "https://example.com/hooks/ultravox",
+				"events": []interface{}{"call.ended"},
+			}, body)
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(webhookResponse)),
+			}, nil
+		},
+	})
+
+	webhook, err := client.CreateWebhook(context.Background(), &ultravox.WebhookDefinition{
+		URL:    "https://example.com/hooks/ultravox",
+		Events: []string{ultravox.WebhookEventCallEnded},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-123", webhook.WebhookID)
+	assert.Equal(t, []string{"s3cret"}, webhook.Secrets)
+
+	t.Run("Invalid definitions", func(t *testing.T) {
+		_, err := client.CreateWebhook(context.Background(), nil)
+		assert.ErrorContains(t, err, "webhook URL is required")
+
+		_, err = client.CreateWebhook(context.Background(), &ultravox.WebhookDefinition{URL: "https://example.com"})
+		assert.ErrorContains(t, err, "at least one webhook event is required")
+	})
+}
+
+func TestClient_RegisterWebhook(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/api/webhooks", req.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"url":    "https://example.com/hooks/ultravox",
+				"events": []interface{}{"call.started", "call.ended"},
+			}, body)
+
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(bytes.NewBufferString(webhookResponse)),
+			}, nil
+		},
+	})
+
+	webhook, err := client.RegisterWebhook(context.Background(), "https://example.com/hooks/ultravox",
+		ultravox.WebhookEventCallStarted, ultravox.WebhookEventCallEnded)
+	require.NoError(t, err)
+	assert.Equal(t, "webhook-123", webhook.WebhookID)
+
+	t.Run("Invalid URLs", func(t *testing.T) {
+		for _, rawURL := range []string{"http://example.com/hooks", "example.com/hooks", "https://", "://bad"} {
+			_, err := client.RegisterWebhook(context.Background(), rawURL, ultravox.WebhookEventCallEnded)
+			assert.Error(t, err, rawURL)
+		}
+	})
+
+	t.Run("No events", func(t *testing.T) {
+		_, err := client.RegisterWebhook(context.Background(), "https://example.com/hooks")
+		assert.ErrorContains(t, err, "at least one webhook event is required")
+	})
+}
+
+func TestClient_DeleteWebhook(t *testing.T) {
+	client := ultravox.NewClient(ultravox.WithAPIKey("test-api-key"))
+	client.WithHTTPClient(&MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Equal(t, "https://api.ultravox.ai/api/webhooks/webhook-123", req.URL.String())
+
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       io.NopCloser(bytes.NewBufferString("")),
+			}, nil
+		},
+	})
+
+	require.NoError(t, client.DeleteWebhook(context.Background(), "webhook-123"))
+	assert.Error(t, client.DeleteWebhook(context.Background(), ""))
+}
+
+// signWebhook signs payload and timestamp as Ultravox does
+func signWebhook(secret, payload []byte, timestamp string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	mac.Write([]byte(timestamp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	secret := []byte("s3cret")
+	payload := []byte(`{"event": "call.ended", "call": {"callId": "call-123"}}`)
+	timestamp := time.Now().UTC().Format(time.RFC3339Nano)
+	signature := signWebhook(secret, payload, timestamp)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		timestamp string
+		header    string
+		wantErr   error
+	}{
+		{name: "Valid", payload: payload, timestamp: timestamp, header: signature},
+		{name: "One of several", payload: payload, timestamp: timestamp, header: "deadbeef, " + signature},
+		{name: "Tampered payload", payload: []byte(`{"event": "call.started"}`), timestamp: timestamp, header: signature, wantErr: ultravox.ErrInvalidWebhookSignature},
+		{name: "Body only", payload: payload, timestamp: timestamp, header: signWebhook(secret, payload, ""), wantErr: ultravox.ErrInvalidWebhookSignature},
+		{name: "Wrong signature", payload: payload, timestamp: timestamp, header: "deadbeef", wantErr: ultravox.ErrInvalidWebhookSignature},
+		{name: "Not hex", payload: payload, timestamp: timestamp, header: "not-a-signature", wantErr: ultravox.ErrInvalidWebhookSignature},
+		{name: "Missing header", payload: payload, timestamp: timestamp, header: "", wantErr: ultravox.ErrInvalidWebhookSignature},
+		{name: "Missing timestamp", payload: payload, timestamp: "", header: signWebhook(secret, payload, ""), wantErr: ultravox.ErrInvalidWebhookSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ultravox.VerifyWebhookSignature(secret, tt.payload, tt.timestamp, tt.header)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Stale timestamp", func(t *testing.T) {
+		for _, offset := range []time.Duration{-time.Hour, time.Hour} {
+			stale := time.Now().Add(offset).UTC().Format(time.RFC3339Nano)
+			err := ultravox.VerifyWebhookSignature(secret, payload, stale, signWebhook(secret, payload, stale))
+			assert.ErrorIs(t, err, ultravox.ErrWebhookTimestampExpired)
+		}
+	})
+
+	t.Run("Missing secret", func(t *testing.T) {
+		assert.Error(t, ultravox.VerifyWebhookSignature(nil, payload, timestamp, signature))
+	})
+}
+
+func TestVerifyWebhookRequest(t *testing.T) {
+	secret := []byte("s3cret")
+	payload := `{"event": "call.ended", "call": {"callId": "call-123"}}`
+	timestamp := time.Now().UTC().Format(time.RFC3339Nano)
+
+	req := httptest.NewRequest(http.MethodPost, "/hooks/ultravox", strings.NewReader(payload))
+	req.Header.Set(ultravox.WebhookTimestampHeader, timestamp)
+	req.Header.Set(ultravox.WebhookSignatureHeader, signWebhook(secret, []byte(payload), timestamp))
+
+	body, err := ultravox.VerifyWebhookRequest(req, secret)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+
+	restored, err := io.ReadAll(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(restored), "the body can be read again")
+
+	t.Run("Invalid signature", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/hooks/ultravox", strings.NewReader(payload))
+		req.Header.Set(ultravox.WebhookTimestampHeader, timestamp)
+		req.Header.Set(ultravox.WebhookSignatureHeader, "deadbeef")
+
+		_, err := ultravox.VerifyWebhookRequest(req, secret)
+		assert.ErrorIs(t, err, ultravox.ErrInvalidWebhookSignature)
+	})
+}